@@ -12,6 +12,9 @@ import (
 	ai "stock_assistant/backend/ai_service/kitex_gen/ai"
 	"stock_assistant/backend/ai_service/kitex_gen/stock"
 	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/common/symbol"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,24 +36,36 @@ func NewLangChainProvider(ctx context.Context, stockClient stockservice.Client,
 	}, nil
 }
 
-// IsTradingTime checks if the current time is within A-share trading hours (Mon-Fri 9:15-15:00)
-// This is a simplified check and does not account for public holidays.
+// IsTradingTime checks if the exchange is open right now: a trading day (holidays
+// included) between the 09:15 call auction and the 15:00 close, lunch break excluded.
 func IsTradingTime() bool {
-	now := time.Now()
-	weekday := now.Weekday()
+	return calendar.IsOpen(calendar.Now())
+}
 
-	// 1. Check if it's weekend
-	if weekday == time.Saturday || weekday == time.Sunday {
-		return false
-	}
+// MarketPhase is the coarse market state the prediction prompt is tuned for
+type MarketPhase string
 
-	// 2. Check time range 09:15 - 15:00
-	// Convert current time to minutes from midnight for easier comparison
-	currentMinutes := now.Hour()*60 + now.Minute()
-	startMinutes := 9*60 + 15 // 09:15
-	endMinutes := 15*60 + 0   // 15:00
+const (
+	PhasePreMarket  MarketPhase = "pre_market"  // 08:00-09:24 on a trading day, before the opening auction settles
+	PhaseIntraday   MarketPhase = "intraday"    // 09:25-15:00 on a trading day, lunch break included
+	PhasePostMarket MarketPhase = "post_market" // Any other time, and all day on weekends and holidays
+)
+
+// GetMarketPhase returns the phase at t, read in exchange time whatever the location of t
+func GetMarketPhase(t time.Time) MarketPhase {
+	t = t.In(calendar.Location)
+	if !calendar.IsTradingDay(t) {
+		return PhasePostMarket
+	}
 
-	return currentMinutes >= startMinutes && currentMinutes <= endMinutes
+	minutes := t.Hour()*60 + t.Minute()
+	switch {
+	case minutes >= 8*60 && minutes < 9*60+25:
+		return PhasePreMarket
+	case minutes >= 9*60+25 && minutes <= 15*60:
+		return PhaseIntraday
+	}
+	return PhasePostMarket
 }
 
 func (p *LangChainProvider) Predict(ctx context.Context, stockCode string, days int32, modelName string) (string, float64, string, error) {
@@ -105,10 +120,12 @@ func (p *LangChainProvider) Predict(ctx context.Context, stockCode string, days
 	// 3. Create Tools
 	stockTool := tool.NewStockPriceTool(p.stockClient)
 	marketTool := tool.NewMarketInfoTool()
-	analysisTool := tool.NewStockAnalysisTool()
+	analysisTool := tool.NewStockAnalysisTool(p.stockClient)
 	sectorTool := tool.NewSectorTool(p.stockClient)
 	dtTool := tool.NewDragonTigerTool()
-	t := []tools.Tool{stockTool, marketTool, analysisTool, sectorTool, dtTool}
+	fundFlowTool := tool.NewFundFlowTool(p.stockClient)
+	northboundTool := tool.NewNorthboundTool(p.stockClient)
+	t := []tools.Tool{stockTool, marketTool, analysisTool, sectorTool, dtTool, fundFlowTool, northboundTool}
 
 	// Pre-fetch stock data to ensure accuracy and avoid tool calling failures
 	stockData, err := stockTool.Call(ctx, stockCode)
@@ -122,6 +139,14 @@ func (p *LangChainProvider) Predict(ctx context.Context, stockCode string, days
 
 	analysisData, _ := analysisTool.Call(ctx, stockCode)
 
+	fundFlow, _ := fundFlowTool.Call(ctx, stockCode)
+
+	valuation, err := tool.GetValuationSummary(ctx, p.stockClient, stockCode)
+	if err != nil {
+		log.Printf("Failed to fetch valuation: %v", err)
+		valuation = "Valuation: Data Unavailable"
+	}
+
 	// Pre-fetch Macro Context
 	sectorContext, _ := sectorTool.Call(ctx, "industry")
 	dtContext, _ := dtTool.Call(ctx, "") // Get today's general list
@@ -133,25 +158,47 @@ func (p *LangChainProvider) Predict(ctx context.Context, stockCode string, days
 
 	// 5. Run Chain
 	// Determine Trading Status and Context
-	isTrading := IsTradingTime()
+	now := calendar.Now()
+	phase := GetMarketPhase(now)
 	tradingStatusStr := "已收盘"
 	predictionFocus := "次日及未来3日预测"
 	timeContextInstruction := `
-- Current Status: Market Closed (Inter-day / Weekend)
+- Current Status: Market Closed (Inter-day / Weekend / Holiday)
 - Focus: Summarize the full-day performance, analyze Dragon & Tiger List data, and provide an outlook for the next trading day and the next 3 days.
 - Order Book Relevance: Low (Snapshot data is less relevant after close).
 `
 
-	if isTrading {
-		tradingStatusStr = "盘中交易 (9:15-15:00)"
+	intradaySection := ""
+	switch phase {
+	case PhasePreMarket:
+		tradingStatusStr = "盘前 (集合竞价前)"
+		predictionFocus = "当日开盘、收盘及未来3日预测"
+		timeContextInstruction = `
+- Current Status: Pre-Market (Today is a trading day, the session has not opened yet)
+- Focus: Use the previous session's close, Dragon & Tiger List data and overnight news to predict today's open and close, and the next 3 days.
+- Order Book Relevance: Low (The book still shows the previous session).
+`
+	case PhaseIntraday:
+		tradingStatusStr = "盘中交易 (9:25-15:00)"
 		predictionFocus = "当日收盘及未来3日预测"
 		timeContextInstruction = `
 - Current Status: Intraday Trading (Live Market)
-- Focus: Analyze real-time Order Book pressure (Total Buy/Sell), WeiBi/WeiCha, and immediate momentum.
+- Focus: Analyze real-time Order Book pressure (Total Buy/Sell), WeiBi/WeiCha, the Intraday Trend against the average price line, and immediate momentum.
 - Order Book Relevance: HIGH. Use it to predict the price trend for the rest of TODAY.
 `
 	}
 
+	// The intraday trend only during continuous trading, not in the opening call auction or the lunch break
+	switch calendar.Default().Phase(now) {
+	case calendar.PhaseMorning, calendar.PhaseAfternoon, calendar.PhaseClosingAuction:
+		intraday, err := tool.GetIntradaySummary(ctx, p.stockClient, stockCode)
+		if err != nil {
+			log.Printf("Failed to fetch intraday trend: %v", err)
+		} else {
+			intradaySection = fmt.Sprintf("\n[Intraday Trend (分时)]\n%s\n", intraday)
+		}
+	}
+
 	input := fmt.Sprintf(`You are an expert A-share Trader AI (Professional Fund Manager level).
 Your task is to provide a comprehensive analysis and prediction for the stock %s.
 Current Time Context: %s (%s)
@@ -159,9 +206,15 @@ Current Time Context: %s (%s)
 Here is the real-time data for the stock:
 [Stock Data]
 %s
-
+[Valuation]
+%s
+%s
 [Advanced Analysis Data]
-(Includes Order Book, Chip Distribution, Industry Info, Dragon Tiger List History, Stock Heat, Regulatory Notices)
+(Includes Order Book, Chip Distribution, Industry Info, Dragon Tiger List History, Stock Heat, Margin Trading, Earnings, Regulatory Notices)
+%s
+
+[Capital Flow (主力资金)]
+(Daily net inflow by order size over the last 20 sessions, multi-day accumulation and today's intraday flow)
 %s
 
 [Market Intelligence]
@@ -184,12 +237,14 @@ Process (Professional Trader Logic):
    - Identify if the stock aligns with current national strategic directions.
    - Check if the stock's industry is in the [Top Industries] list. If yes, it's a "Main Line" stock (High Potential).
    - Policy-supported sectors enjoy valuation premiums.
+   - **Valuation**: Use [Valuation] to tell a cheap stock (low percentile, below the industry median) from a crowded one near the top of its own range.
 
 3. **Funds & Chips (The "Ground")**:
    - **Dragon Tiger List**: 
      - Check if the stock is on [Today's Hot Money] list.
      - Check "Dragon Tiger List History" in [Advanced Analysis Data].
      - Identify if "Hot Money" (e.g., Zhao Laoge, Lasa Tiantuan) or "Institutions" are buying.
+   - **Capital Flow**: Use [Capital Flow] to tell multi-day accumulation (持续流入) from a one-off inflow; check whether super-large orders lead and whether today's intraday flow confirms the trend.
    - **Chip Distribution**: Check "Winner Rate" and "Cost Range".
    - **Order Book**: Analyze intraday pressure.
 
@@ -200,6 +255,9 @@ Process (Professional Trader Logic):
 5. **Risk Control (The "Shield" - MANDATORY CHECK)**:
    - **Regulatory Notices**: Check for Inquiry Letters/Regulatory Letters.
    - **Volatility Rules**: Check for recent abnormal fluctuations.
+   - **Leverage**: Check "Margin Trading". A financing balance that keeps rising while the price stalls means crowded leveraged longs.
+   - **Earnings Event**: Check "Earnings". A report due within days is a gap risk; a preview with a large profit drop (预减/首亏) weighs on the price until it is priced in.
+   - **Share Unlocks**: Check "限售解禁预警" in [Quantitative Risk Check]. An unlock worth a large share of the float (e.g. >5%%) brings selling pressure into and after the date.
 
 6. **Prediction**:
    - Provide a prediction for the trend (Up, Down, Neutral) for BOTH the %s.
@@ -216,7 +274,7 @@ Output requirements:
   - 时间背景: %s
   - **核心逻辑分析**:
     - 🏛️ 政策与宏观 (天时) - 包含板块效应分析
-    - 💰 资金与筹码 (地利) - 包含龙虎榜游资分析
+    - 💰 资金与筹码 (地利) - 包含主力资金流向与龙虎榜游资分析
     - 🗣️ 情绪与心理 (人和) - 包含个股热度与联动
   - **风控评估 (风控)**: 监管与波动率检查
   - **走势预测 (%s)**: [趋势] - [理由]
@@ -236,7 +294,7 @@ Final Answer:
 {"confidence": 0.85, "news_summary": "Policy support for low-altitude economy and 5G drives positive outlook despite short-term selling pressure."}
 
 Output your final answer starting with "Final Answer:", followed by the detailed analysis in Chinese, and then the metadata block.
-`, stockCode, time.Now().Format("2006-01-02 15:04:05"), tradingStatusStr, stockData, analysisData, marketInfo, sectorContext, dtContext, timeContextInstruction, predictionFocus, tradingStatusStr, predictionFocus)
+`, stockCode, now.Format("2006-01-02 15:04:05"), tradingStatusStr, stockData, valuation, intradaySection, analysisData, fundFlow, marketInfo, sectorContext, dtContext, timeContextInstruction, predictionFocus, tradingStatusStr, predictionFocus)
 
 	res, err := chains.Run(ctx, executor, input)
 	if err != nil {
@@ -391,30 +449,26 @@ func (p *LangChainProvider) RecognizeImage(ctx context.Context, imageData []byte
 	if err := json.Unmarshal([]byte(content), &tempStocks); err != nil {
 		// Try to use regex if JSON parsing fails
 		log.Printf("JSON unmarshal failed: %v, trying regex", err)
-		// Simple regex to find codes like sh/sz + 6 digits OR just 6 digits
-		re := regexp.MustCompile(`((sh|sz)\d{6})|(\d{6})`)
+		// Simple regex to find codes like sh/sz/bj + 6 digits OR just 6 digits
+		re := regexp.MustCompile(`((sh|sz|bj)\d{6})|(\d{6})`)
 		matches := re.FindAllString(content, -1)
 
 		uniqueCodes := make(map[string]bool)
 
 		for _, match := range matches {
-			code := match
-			// Fix stock code prefix if missing
-			if len(code) == 6 && !strings.HasPrefix(code, "sh") && !strings.HasPrefix(code, "sz") {
-				if strings.HasPrefix(code, "6") {
-					code = "sh" + code
-				} else if strings.HasPrefix(code, "0") || strings.HasPrefix(code, "3") {
-					code = "sz" + code
-				}
-				// Other cases ignored
+			// Any six digits match, only keep what is a valid security code
+			sec, err := symbol.Parse(match)
+			if err != nil {
+				continue
 			}
+			code := sec.Prefixed()
 
-			// Avoid duplicates and ensure valid format
-			if !uniqueCodes[code] && (strings.HasPrefix(code, "sh") || strings.HasPrefix(code, "sz")) {
+			// Avoid duplicates
+			if !uniqueCodes[code] {
 				uniqueCodes[code] = true
 				stocks = append(stocks, &ai.RecognizedStock{
 					Code: code,
-					Name: "Unknown", // Cannot reliably extract name via regex without structure
+					// Regex has no name, it is filled from the security master below
 				})
 			}
 		}
@@ -428,14 +482,9 @@ func (p *LangChainProvider) RecognizeImage(ctx context.Context, imageData []byte
 	} else {
 		for _, s := range tempStocks {
 			code := s.Code
-			// Fix stock code prefix if missing
-			if len(code) == 6 && !strings.HasPrefix(code, "sh") && !strings.HasPrefix(code, "sz") {
-				if strings.HasPrefix(code, "6") {
-					code = "sh" + code
-				} else if strings.HasPrefix(code, "0") || strings.HasPrefix(code, "3") {
-					code = "sz" + code
-				}
-				// Other cases (e.g. 4, 8) might be Beijing stock exchange or others, ignore for now or default
+			// Normalize to the prefixed form, codes we cannot parse are kept as the model wrote them
+			if sec, err := symbol.Parse(code); err == nil {
+				code = sec.Prefixed()
 			}
 
 			stocks = append(stocks, &ai.RecognizedStock{
@@ -445,10 +494,42 @@ func (p *LangChainProvider) RecognizeImage(ctx context.Context, imageData []byte
 		}
 	}
 
+	p.resolveRecognizedStocks(ctx, stocks)
 	return stocks, nil
 }
 
-func (p *LangChainProvider) ReviewMarket(ctx context.Context, sectors []*stock.SectorInfo, limitUps []*stock.LimitUpStock, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketReviewResponse, error) {
+// resolveRecognizedStocks completes what the model or the regex fallback left out:
+// the name of a bare code, or the code of a bare name, using the security master.
+func (p *LangChainProvider) resolveRecognizedStocks(ctx context.Context, stocks []*ai.RecognizedStock) {
+	for _, s := range stocks {
+		var query string
+		switch {
+		case s.Code != "" && (s.Name == "" || s.Name == "Unknown"):
+			query = s.Code
+		case s.Code == "" && s.Name != "":
+			query = s.Name
+		default:
+			continue
+		}
+
+		resp, err := p.stockClient.SearchSecurities(ctx, &stock.SearchSecuritiesRequest{Query: query, Limit: 1})
+		if err != nil {
+			log.Printf("Failed to resolve recognized stock %q: %v", query, err)
+		} else if len(resp.Securities) > 0 {
+			sec := resp.Securities[0]
+			// Only trust exact hits, a fuzzy match could be a different stock
+			if strings.EqualFold(sec.Code, s.Code) || sec.Name == s.Name {
+				s.Code = sec.Code
+				s.Name = sec.Name
+			}
+		}
+		if s.Name == "" {
+			s.Name = "Unknown"
+		}
+	}
+}
+
+func (p *LangChainProvider) ReviewMarket(ctx context.Context, indices []*stock.IndexQuote, breadth *stock.GetMarketBreadthResponse, northbound *stock.GetNorthboundFlowResponse, northboundTop *stock.GetNorthboundTopStocksResponse, sectors []*stock.SectorInfo, limitUps []*stock.LimitUpStock, sentiment *stock.SentimentSnapshot, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketReviewResponse, error) {
 	// ... (Implementation for MarketReview - Focus on Today's Summary)
 	// 1. Determine ModelConfig
 	var cfg ModelConfig
@@ -473,6 +554,10 @@ func (p *LangChainProvider) ReviewMarket(ctx context.Context, sectors []*stock.S
 	}
 
 	// 3. Prepare Data Context
+	indexSummary := formatIndexSummary(indices)
+	breadthSummary := formatMarketBreadth(breadth)
+	northboundSummary := tool.SummarizeNorthbound(northbound, northboundTop)
+
 	var sectorSummary strings.Builder
 	sectorSummary.WriteString("Top Sectors:\n")
 	for i, s := range sectors {
@@ -483,12 +568,14 @@ func (p *LangChainProvider) ReviewMarket(ctx context.Context, sectors []*stock.S
 	}
 
 	var limitUpSummary strings.Builder
+	limitUpSummary.WriteString(formatSentimentSnapshot(sentiment))
 	limitUpSummary.WriteString(fmt.Sprintf("Limit Up Pool (Total: %d):\n", len(limitUps)))
 	// Simple stats
 	typeCount := make(map[string]int)
 	for _, s := range limitUps {
 		typeCount[s.LimitUpType]++
-		limitUpSummary.WriteString(fmt.Sprintf("- %s: %s, %s, %s\n", s.Name, s.LimitUpType, s.Reason, s.ChangePercent))
+		limitUpSummary.WriteString(fmt.Sprintf("- %s: %s, %s, %.2f%%, First Seal: %s, Seal Amount: %.0f Wan, Opened: %d times, Turnover: %.2f%%\n",
+			s.Name, s.LimitUpType, s.Reason, s.ChangePercent, s.FirstSealTime, s.SealAmount/10000, s.OpenCount, s.TurnoverRate))
 	}
 
 	var dtSummary strings.Builder
@@ -517,6 +604,15 @@ Your task is to provide a comprehensive "Market Review" (复盘) for the A-share
 
 Here is the market data:
 
+[Index Overview]
+%s
+
+[Market Breadth]
+%s
+
+[Northbound Capital (北向资金)]
+%s
+
 [Sector Performance]
 %s
 
@@ -534,7 +630,7 @@ If Limit-Up Data is empty, do not list any "Hot Stocks" unless they are from the
 If NO data is available at all, return a summary stating that market data is unavailable.
 
 Structure:
-1. **Market Summary (市场总览)**: A brief summary of today's market emotion and main themes.
+1. **Market Summary (市场总览)**: A brief summary of today's index performance, market breadth (up/down counts, turnover and how they compare to the previous sessions), northbound capital (direction, persistence and the stocks it traded), market emotion and main themes.
 2. **Sector Analysis (板块分析)**: Which sectors are strong? Is there a clear main line? Where is the money flowing?
 3. **Sentiment Analysis (情绪分析)**: Analyze the limit-up pool. Is the sentiment heating up or cooling down? Are there high-space stocks (连板高度)?
 4. **Hot Money Analysis (游资动向)**: Based on Dragon Tiger List, where are the active funds?
@@ -551,7 +647,7 @@ Output ONLY a JSON object with the following fields:
 }
 
 Ensure the response is valid JSON. Do not include markdown formatting like `+"```json"+`.
-`, date, sectorSummary.String(), limitUpSummary.String(), dtSummary.String())
+`, date, indexSummary, breadthSummary, northboundSummary, sectorSummary.String(), limitUpSummary.String(), dtSummary.String())
 
 	messages := []llms.MessageContent{
 		{
@@ -593,7 +689,7 @@ Ensure the response is valid JSON. Do not include markdown formatting like `+"``
 	return &review, nil
 }
 
-func (p *LangChainProvider) AnalyzeMarket(ctx context.Context, sectors []*stock.SectorInfo, limitUps []*stock.LimitUpStock, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketAnalysisResponse, error) {
+func (p *LangChainProvider) AnalyzeMarket(ctx context.Context, indices []*stock.IndexQuote, sectors []*stock.SectorInfo, rotation []*stock.SectorRotationItem, limitUps []*stock.LimitUpStock, sentiment *stock.SentimentSnapshot, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketAnalysisResponse, error) {
 	// 1. Determine ModelConfig
 	var cfg ModelConfig
 	if p.fileConfig != nil {
@@ -617,6 +713,8 @@ func (p *LangChainProvider) AnalyzeMarket(ctx context.Context, sectors []*stock.
 	}
 
 	// 3. Prepare Data Context (Reuse same context logic as ReviewMarket)
+	indexSummary := formatIndexSummary(indices)
+
 	var sectorSummary strings.Builder
 	sectorSummary.WriteString("Top Sectors:\n")
 	for i, s := range sectors {
@@ -625,13 +723,16 @@ func (p *LangChainProvider) AnalyzeMarket(ctx context.Context, sectors []*stock.
 		}
 		sectorSummary.WriteString(fmt.Sprintf("- %s: +%.2f%% (Net Inflow: %.2f), Top Stock: %s\n", s.Name, s.ChangePercent, s.NetInflow, s.TopStockName))
 	}
+	sectorSummary.WriteString(formatSectorRotation(rotation))
 
 	var limitUpSummary strings.Builder
+	limitUpSummary.WriteString(formatSentimentSnapshot(sentiment))
 	limitUpSummary.WriteString(fmt.Sprintf("Limit Up Pool (Total: %d):\n", len(limitUps)))
 	typeCount := make(map[string]int)
 	for _, s := range limitUps {
 		typeCount[s.LimitUpType]++
-		limitUpSummary.WriteString(fmt.Sprintf("- %s: %s, %s, %s\n", s.Name, s.LimitUpType, s.Reason, s.ChangePercent))
+		limitUpSummary.WriteString(fmt.Sprintf("- %s: %s, %s, %.2f%%, First Seal: %s, Seal Amount: %.0f Wan, Opened: %d times, Turnover: %.2f%%\n",
+			s.Name, s.LimitUpType, s.Reason, s.ChangePercent, s.FirstSealTime, s.SealAmount/10000, s.OpenCount, s.TurnoverRate))
 	}
 
 	var dtSummary strings.Builder
@@ -649,6 +750,9 @@ Your task is to provide a "Pre-market Analysis" (盘前分析) for the A-share m
 
 Here is the latest available market data (representing the most recent trading session, usually yesterday or last Friday):
 
+[Index Overview]
+%s
+
 [Sector Performance]
 %s
 
@@ -669,9 +773,9 @@ If NO data is available at all, return a summary stating that market data is una
 Structure:
 1. **Hot Stocks (热门股票)**: Identify 3-5 stocks that are likely to be active tomorrow based on limit-up momentum or dragon tiger list funds.
 2. **Recommended Stocks (推荐关注)**: Recommend 1-3 stocks with strong logic (e.g., sector resonance, hot money inflow). Provide brief reasons.
-3. **Risks (风险提示)**: What should traders watch out for in the next session? (e.g., high-level divergence, sector rotation failure).
-4. **Opportunities (机会展望)**: Which sectors or themes might lead tomorrow?
-5. **Analysis Summary (分析总结)**: A concise overview of the strategy for tomorrow.
+3. **Risks (风险提示)**: What should traders watch out for in the next session? (e.g., high-level divergence, sector rotation failure, fading main lines).
+4. **Opportunities (机会展望)**: Which sectors or themes might lead tomorrow? Use the multi-day rotation to tell an established or newly rising main line (主线) from a one-day spike or a fading one.
+5. **Analysis Summary (分析总结)**: A concise overview of the strategy for tomorrow, taking the index trend and market breadth into account.
 
 Output ONLY a JSON object with the following fields:
 {
@@ -683,7 +787,7 @@ Output ONLY a JSON object with the following fields:
 }
 
 Ensure the response is valid JSON. Do not include markdown formatting like `+"```json"+`.
-`, sectorSummary.String(), limitUpSummary.String(), dtSummary.String())
+`, indexSummary, sectorSummary.String(), limitUpSummary.String(), dtSummary.String())
 
 	messages := []llms.MessageContent{
 		{
@@ -723,3 +827,92 @@ Ensure the response is valid JSON. Do not include markdown formatting like `+"``
 
 	return &analysis, nil
 }
+
+// formatIndexSummary renders the major indices with their up/down/flat member counts,
+// which a past session rebuilt from the daily closes does not have
+func formatIndexSummary(indices []*stock.IndexQuote) string {
+	if len(indices) == 0 {
+		return "No index data available"
+	}
+	var sb strings.Builder
+	for _, idx := range indices {
+		sb.WriteString(fmt.Sprintf("- %s(%s): %.2f, %+.2f%%, Amount: %.0f亿",
+			idx.Name, idx.Code, idx.CurrentPrice, idx.ChangePercent, idx.Amount/1e8))
+		if idx.UpCount+idx.DownCount+idx.FlatCount > 0 {
+			sb.WriteString(fmt.Sprintf(", Up/Down/Flat: %d/%d/%d", idx.UpCount, idx.DownCount, idx.FlatCount))
+		}
+		sb.WriteString("\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// formatSentimentSnapshot renders the board counts line, empty when the snapshot is unavailable
+func formatSentimentSnapshot(snap *stock.SentimentSnapshot) string {
+	if snap == nil {
+		return ""
+	}
+	return fmt.Sprintf("Board Sentiment: Limit Up %d, Broken %d (炸板率 %.2f%%), Limit Down %d, Highest Board %d\n",
+		snap.LimitUpCount, snap.BrokenCount, snap.BrokenRate, snap.LimitDownCount, snap.HighestBoard)
+}
+
+// formatSectorRotation renders the multi-day sector ranking, it is empty without data
+func formatSectorRotation(rotation []*stock.SectorRotationItem) string {
+	if len(rotation) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("Sector Rotation (ranks oldest to latest, by momentum):\n")
+	for i, s := range rotation {
+		if i >= 10 {
+			break
+		}
+		ranks := make([]string, len(s.Ranks))
+		for j, r := range s.Ranks {
+			if r == 0 {
+				ranks[j] = "-"
+			} else {
+				ranks[j] = strconv.Itoa(int(r))
+			}
+		}
+		sb.WriteString(fmt.Sprintf("- %s: Ranks %s, Cumulative %+.2f%%, Net Inflow %.1f Yi, Top10 Streak %d days, Momentum %.1f, Trend %s\n",
+			s.Name, strings.Join(ranks, "→"), s.CumulativeChange, s.CumulativeNetInflow/1e8, s.Top10Streak, s.MomentumScore, s.Trend))
+	}
+	return sb.String()
+}
+
+// formatMarketBreadth renders the advance/decline counts, the change distribution and the
+// board breakdown, followed by the previous sessions for comparison
+func formatMarketBreadth(resp *stock.GetMarketBreadthResponse) string {
+	if resp == nil || resp.Breadth == nil {
+		return "No market breadth data available"
+	}
+	b := resp.Breadth
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s: Up %d, Down %d, Flat %d, Limit Up %d, Limit Down %d, Turnover %.0f Yi",
+		b.Date, b.Advance, b.Decline, b.Flat, b.LimitUp, b.LimitDown, b.Amount/1e8))
+	if resp.PrevAmount > 0 {
+		sb.WriteString(fmt.Sprintf(" (%+.2f%% vs previous session)", resp.AmountChangePercent))
+	}
+	sb.WriteString("\n")
+
+	buckets := make([]string, 0, len(b.Buckets))
+	for _, bucket := range b.Buckets {
+		buckets = append(buckets, fmt.Sprintf("%s: %d", bucket.Label, bucket.Count))
+	}
+	sb.WriteString("Stocks by change %: " + strings.Join(buckets, ", ") + "\n")
+
+	for _, board := range b.Boards {
+		sb.WriteString(fmt.Sprintf("- %s: Up %d, Down %d, Limit Up %d, Limit Down %d, Turnover %.0f Yi\n",
+			board.Board, board.Advance, board.Decline, board.LimitUp, board.LimitDown, board.Amount/1e8))
+	}
+
+	if len(resp.History) > 0 {
+		sb.WriteString("Previous sessions:\n")
+		for _, h := range resp.History {
+			sb.WriteString(fmt.Sprintf("- %s: Up %d, Down %d, Limit Up %d, Limit Down %d, Turnover %.0f Yi\n",
+				h.Date, h.Advance, h.Decline, h.LimitUp, h.LimitDown, h.Amount/1e8))
+		}
+	}
+	return sb.String()
+}
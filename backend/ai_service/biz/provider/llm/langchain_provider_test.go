@@ -4,6 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/common/calendar"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,17 +68,76 @@ func TestGetMarketPhase(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Construct a base time (e.g., a Monday)
-			baseDate := time.Date(2023, 5, 22, 0, 0, 0, 0, time.Local) // Monday
+			baseDate := time.Date(2023, 5, 22, 0, 0, 0, 0, calendar.Location) // Monday
 			if tt.weekend {
-				baseDate = time.Date(2023, 5, 20, 0, 0, 0, 0, time.Local) // Saturday
+				baseDate = time.Date(2023, 5, 20, 0, 0, 0, 0, calendar.Location) // Saturday
 			}
 
-			parsedTime, _ := time.Parse("15:04", tt.timeStr)
+			parsedTime, err := time.Parse("15:04", tt.timeStr)
+			if err != nil {
+				t.Fatalf("parse %q: %v", tt.timeStr, err)
+			}
 			testTime := time.Date(baseDate.Year(), baseDate.Month(), baseDate.Day(),
-				parsedTime.Hour(), parsedTime.Minute(), 0, 0, time.Local)
+				parsedTime.Hour(), parsedTime.Minute(), 0, 0, calendar.Location)
 
 			phase := GetMarketPhase(testTime)
 			assert.Equal(t, tt.expected, phase)
 		})
 	}
+
+	// Holidays are closed all day
+	assert.Equal(t, PhasePostMarket, GetMarketPhase(time.Date(2025, 10, 1, 10, 0, 0, 0, calendar.Location))) // National Day
+	assert.Equal(t, PhasePostMarket, GetMarketPhase(time.Date(2025, 5, 1, 8, 30, 0, 0, calendar.Location)))  // Labour Day
+
+	// The time is read in exchange time: 02:00 UTC is 10:00 and 07:01 UTC is 15:01 in Shanghai
+	assert.Equal(t, PhaseIntraday, GetMarketPhase(time.Date(2023, 5, 22, 2, 0, 0, 0, time.UTC)))
+	assert.Equal(t, PhasePostMarket, GetMarketPhase(time.Date(2023, 5, 22, 7, 1, 0, 0, time.UTC)))
+	// 23:30 UTC on Sunday is already 07:30 on Monday, 00:30 UTC is 08:30
+	assert.Equal(t, PhasePostMarket, GetMarketPhase(time.Date(2023, 5, 21, 23, 30, 0, 0, time.UTC)))
+	assert.Equal(t, PhasePreMarket, GetMarketPhase(time.Date(2023, 5, 22, 0, 30, 0, 0, time.UTC)))
+}
+
+func TestFormatSectorRotation(t *testing.T) {
+	assert.Equal(t, "", formatSectorRotation(nil))
+
+	out := formatSectorRotation([]*stock.SectorRotationItem{{
+		Name:                "半导体",
+		Ranks:               []int32{0, 12, 3},
+		CumulativeChange:    6.5,
+		CumulativeNetInflow: 2.3e9,
+		Top10Streak:         1,
+		MomentumScore:       81.2,
+		Trend:               "rising",
+	}})
+	assert.Contains(t, out, "半导体: Ranks -→12→3, Cumulative +6.50%, Net Inflow 23.0 Yi, Top10 Streak 1 days, Momentum 81.2, Trend rising")
+}
+
+func TestFormatMarketBreadth(t *testing.T) {
+	assert.Equal(t, "No market breadth data available", formatMarketBreadth(&stock.GetMarketBreadthResponse{}))
+
+	out := formatMarketBreadth(&stock.GetMarketBreadthResponse{
+		Breadth: &stock.MarketBreadth{
+			Date: "2025-09-30", Advance: 3200, Decline: 1900, Flat: 120, LimitUp: 80, LimitDown: 5, Amount: 2.15e12,
+			Buckets: []*stock.BreadthBucket{{Label: "limit_up", Count: 80}},
+			Boards:  []*stock.BreadthBoard{{Board: "chinext", Advance: 900, Decline: 400, Amount: 6e11}},
+		},
+		PrevAmount:          2e12,
+		AmountChangePercent: 7.5,
+		History:             []*stock.MarketBreadth{{Date: "2025-09-29", Advance: 1000, Decline: 4200, Amount: 2e12}},
+	})
+	assert.Contains(t, out, "2025-09-30: Up 3200, Down 1900, Flat 120, Limit Up 80, Limit Down 5, Turnover 21500 Yi (+7.50% vs previous session)")
+	assert.Contains(t, out, "limit_up: 80")
+	assert.Contains(t, out, "- chinext: Up 900, Down 400")
+	assert.Contains(t, out, "- 2025-09-29: Up 1000, Down 4200")
+}
+
+func TestFormatIndexSummary(t *testing.T) {
+	assert.Equal(t, "No index data available", formatIndexSummary(nil))
+
+	out := formatIndexSummary([]*stock.IndexQuote{
+		{Code: "sh000001", Name: "上证指数", CurrentPrice: 3882.78, ChangePercent: 0.52, Amount: 8.9e11, UpCount: 1500, DownCount: 700, FlatCount: 80},
+		{Code: "sz399006", Name: "创业板指", CurrentPrice: 3238.16, ChangePercent: -0.2, Amount: 4.1e11}, // Past session, no counts
+	})
+	assert.Equal(t, "- 上证指数(sh000001): 3882.78, +0.52%, Amount: 8900亿, Up/Down/Flat: 1500/700/80\n"+
+		"- 创业板指(sz399006): 3238.16, -0.20%, Amount: 4100亿", out)
 }
@@ -9,6 +9,6 @@ import (
 type Provider interface {
 	Predict(ctx context.Context, stockCode string, days int32, modelName string) (string, float64, string, error)
 	RecognizeImage(ctx context.Context, imageData []byte, modelName string) ([]*ai.RecognizedStock, error)
-	ReviewMarket(ctx context.Context, sectors []*stock.SectorInfo, limitUps []*stock.LimitUpStock, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketReviewResponse, error)
-	AnalyzeMarket(ctx context.Context, sectors []*stock.SectorInfo, limitUps []*stock.LimitUpStock, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketAnalysisResponse, error)
+	ReviewMarket(ctx context.Context, indices []*stock.IndexQuote, breadth *stock.GetMarketBreadthResponse, northbound *stock.GetNorthboundFlowResponse, northboundTop *stock.GetNorthboundTopStocksResponse, sectors []*stock.SectorInfo, limitUps []*stock.LimitUpStock, sentiment *stock.SentimentSnapshot, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketReviewResponse, error)
+	AnalyzeMarket(ctx context.Context, indices []*stock.IndexQuote, sectors []*stock.SectorInfo, rotation []*stock.SectorRotationItem, limitUps []*stock.LimitUpStock, sentiment *stock.SentimentSnapshot, dragonTigerList []*stock.DragonTigerItem, date string) (*ai.MarketAnalysisResponse, error)
 }
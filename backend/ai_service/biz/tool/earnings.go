@@ -0,0 +1,121 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+	"stock_assistant/backend/common/calendar"
+)
+
+// GetEarningsSummary fetches the earnings calendar of a stock over the last year and the
+// year ahead and condenses it for the risk section
+func GetEarningsSummary(ctx context.Context, client stockservice.Client, code string) (string, error) {
+	today := calendar.Now()
+	resp, err := client.GetEarningsCalendar(ctx, &stock.GetEarningsCalendarRequest{
+		Code:  code,
+		Start: today.AddDate(-1, 0, 0).Format(calendar.DateLayout),
+	})
+	if err != nil {
+		return "", err
+	}
+	return SummarizeEarnings(resp, today), nil
+}
+
+// SummarizeEarnings flags the next report due, e.g. "FY report due on 2024-04-20 (in 3 days)",
+// and the latest performance preview and express report
+func SummarizeEarnings(resp *stock.GetEarningsCalendarResponse, today time.Time) string {
+	var sb strings.Builder
+	date := today.Format(calendar.DateLayout)
+
+	// The next report not yet published
+	var next *stock.EarningsDisclosure
+	for _, d := range resp.Disclosures {
+		if d.ActualDate == "" && d.ScheduledDate >= date {
+			next = d
+			break
+		}
+	}
+	if next == nil {
+		sb.WriteString("Next report: None scheduled\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("Next report: %.4s %s due on %s (%s)",
+			next.ReportDate, next.ReportType, next.ScheduledDate, daysUntil(next.ScheduledDate, today)))
+		if next.Reschedules > 0 {
+			sb.WriteString(fmt.Sprintf(", rescheduled %d time(s) from %s", next.Reschedules, next.FirstScheduledDate))
+		}
+		sb.WriteString("\n")
+	}
+
+	// The newest notice of the net profit preview
+	var preview *stock.EarningsPreview
+	for _, p := range resp.Previews {
+		if strings.Contains(p.Indicator, "净利润") && !strings.Contains(p.Indicator, "扣除") {
+			preview = p
+		}
+	}
+	if preview != nil {
+		sb.WriteString(fmt.Sprintf("Preview (%s) for period %s: %s, net profit %s, %s\n",
+			preview.NoticeDate, preview.ReportDate, preview.Type,
+			formatRange(preview.ChangeLower, preview.ChangeUpper, "%+.2f%%"),
+			formatRange(preview.Lower/1e8, preview.Upper/1e8, "%.2f亿")))
+		if preview.Reason != "" {
+			sb.WriteString(fmt.Sprintf("Reason: %s\n", truncateRunes(preview.Reason, 200)))
+		}
+	}
+
+	if len(resp.Expresses) > 0 {
+		e := resp.Expresses[len(resp.Expresses)-1]
+		sb.WriteString(fmt.Sprintf("Express report (%s) for period %s: Revenue %.2f亿 (%+.2f%%), Net Profit %.2f亿 (%+.2f%%), EPS %.2f\n",
+			e.NoticeDate, e.ReportDate, e.Revenue/1e8, e.RevenueYoy, e.NetProfit/1e8, e.NetProfitYoy, e.Eps))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// daysUntil describes how far date (YYYY-MM-DD) is from today in calendar days
+func daysUntil(date string, today time.Time) string {
+	days, ok := calendarDays(date, today)
+	if !ok {
+		return "unknown"
+	}
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "tomorrow"
+	default:
+		return fmt.Sprintf("in %d days", days)
+	}
+}
+
+// calendarDays counts the calendar days from today to date (YYYY-MM-DD), false when date is invalid
+func calendarDays(date string, today time.Time) (int, bool) {
+	d, err := time.ParseInLocation(calendar.DateLayout, date, today.Location())
+	if err != nil {
+		return 0, false
+	}
+	start := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	return int(d.Sub(start).Hours() / 24), true
+}
+
+// formatRange formats a forecast range, a single value when both ends are equal or one is missing
+func formatRange(lower, upper float64, format string) string {
+	if lower == upper || upper == 0 {
+		return fmt.Sprintf(format, lower)
+	}
+	if lower == 0 {
+		return fmt.Sprintf(format, upper)
+	}
+	return fmt.Sprintf(format+" to "+format, lower, upper)
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
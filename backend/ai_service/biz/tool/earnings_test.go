@@ -0,0 +1,48 @@
+package tool
+
+import (
+	"testing"
+	"time"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeEarnings(t *testing.T) {
+	today := time.Date(2024, 4, 17, 10, 0, 0, 0, time.UTC)
+	resp := &stock.GetEarningsCalendarResponse{
+		Disclosures: []*stock.EarningsDisclosure{
+			{ReportDate: "2023-09-30", ReportType: "Q3", ScheduledDate: "2023-10-28", ActualDate: "2023-10-27"},
+			{ReportDate: "2023-12-31", ReportType: "FY", ScheduledDate: "2024-04-20", FirstScheduledDate: "2024-04-12", Reschedules: 1},
+			{ReportDate: "2024-03-31", ReportType: "Q1", ScheduledDate: "2024-04-27"},
+		},
+		Previews: []*stock.EarningsPreview{
+			{ReportDate: "2023-12-31", NoticeDate: "2024-01-30", Indicator: "扣除非经常性损益后的净利润", Type: "预减", ChangeLower: -70, ChangeUpper: -65},
+			{ReportDate: "2023-12-31", NoticeDate: "2024-01-30", Indicator: "归属于上市公司股东的净利润", Type: "预减",
+				Lower: 2e8, Upper: 2.5e8, ChangeLower: -60, ChangeUpper: -50, Reason: "需求下滑"},
+			{ReportDate: "2023-12-31", NoticeDate: "2024-01-30", Indicator: "营业收入", Type: "略减", ChangeLower: -10, ChangeUpper: -5},
+		},
+	}
+
+	summary := SummarizeEarnings(resp, today)
+	assert.Contains(t, summary, "Next report: 2023 FY due on 2024-04-20 (in 3 days), rescheduled 1 time(s) from 2024-04-12")
+	assert.Contains(t, summary, "Preview (2024-01-30) for period 2023-12-31: 预减, net profit -60.00% to -50.00%, 2.00亿 to 2.50亿")
+	assert.Contains(t, summary, "Reason: 需求下滑")
+	assert.NotContains(t, summary, "Express report")
+
+	resp.Expresses = []*stock.EarningsExpress{
+		{ReportDate: "2023-12-31", NoticeDate: "2024-02-27", Revenue: 1.2e9, RevenueYoy: -8, NetProfit: 2.2e8, NetProfitYoy: -55, Eps: 0.31},
+	}
+	assert.Contains(t, SummarizeEarnings(resp, today), "Express report (2024-02-27) for period 2023-12-31: Revenue 12.00亿 (-8.00%), Net Profit 2.20亿 (-55.00%), EPS 0.31")
+
+	assert.Equal(t, "Next report: None scheduled", SummarizeEarnings(&stock.GetEarningsCalendarResponse{}, today))
+}
+
+func TestDaysUntil(t *testing.T) {
+	today := time.Date(2024, 4, 17, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, "today", daysUntil("2024-04-17", today))
+	assert.Equal(t, "tomorrow", daysUntil("2024-04-18", today))
+	assert.Equal(t, "in 10 days", daysUntil("2024-04-27", today))
+	assert.Equal(t, "unknown", daysUntil("", today))
+}
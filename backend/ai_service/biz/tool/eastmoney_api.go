@@ -12,6 +12,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"stock_assistant/backend/common/symbol"
 )
 
 // Common HTTP client for EastMoney APIs
@@ -105,36 +107,6 @@ type DragonTigerHistoryResponse struct {
 	} `json:"result"`
 }
 
-// OrderBookResponse matches http://push2.eastmoney.com/api/qt/stock/get
-type OrderBookResponse struct {
-	Data struct {
-		Buy1Price float64 `json:"f19"`
-		Buy1Vol   int     `json:"f20"`
-		Buy2Price float64 `json:"f17"`
-		Buy2Vol   int     `json:"f18"`
-		Buy3Price float64 `json:"f15"`
-		Buy3Vol   int     `json:"f16"`
-		Buy4Price float64 `json:"f13"`
-		Buy4Vol   int     `json:"f14"`
-		Buy5Price float64 `json:"f11"`
-		Buy5Vol   int     `json:"f12"`
-
-		Sell1Price float64 `json:"f39"`
-		Sell1Vol   int     `json:"f40"`
-		Sell2Price float64 `json:"f37"`
-		Sell2Vol   int     `json:"f38"`
-		Sell3Price float64 `json:"f35"`
-		Sell3Vol   int     `json:"f36"`
-		Sell4Price float64 `json:"f33"`
-		Sell4Vol   int     `json:"f34"`
-		Sell5Price float64 `json:"f31"`
-		Sell5Vol   int     `json:"f32"`
-
-		WeiBi  interface{} `json:"f191"` // Wei Bi (Commission Ratio)
-		WeiCha interface{} `json:"f192"` // Wei Cha (Commission Diff)
-	} `json:"data"`
-}
-
 // ChipDistributionResponse matches https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=RPT_CYQ_OUTPUT...
 type ChipDistributionResponse struct {
 	Success bool `json:"success"`
@@ -172,39 +144,6 @@ type NoticeResponse struct {
 	} `json:"data"`
 }
 
-// KLineResponse matches EastMoney K-line interface
-type KLineResponse struct {
-	Data struct {
-		Code   string   `json:"code"`
-		Name   string   `json:"name"`
-		KLines []string `json:"klines"` // Format: "2024-05-15,10.00,-1.5" (Date, Close, ChangePct)
-	} `json:"data"`
-}
-
-// GetKLineData fetches historical K-line data
-// codeOrSecId: stock code or secid
-// days: number of days
-func GetKLineData(codeOrSecId string, days int) ([]string, error) {
-	secId := codeOrSecId
-	if !strings.Contains(codeOrSecId, ".") {
-		secId = getSecId(codeOrSecId)
-	}
-
-	// fields2: f51=Date, f53=Close, f56=Volume, f59=ChangePercent
-	// Note: API returns fields sorted by ID: f51, f53, f56, f59
-	url := fmt.Sprintf("http://push2his.eastmoney.com/api/qt/stock/kline/get?secid=%s&fields1=f1&fields2=f51,f53,f56,f59&klt=101&fqt=1&end=20500101&lmt=%d", secId, days)
-
-	var resp KLineResponse
-	if err := fetchJSON(url, &resp); err != nil {
-		return nil, err
-	}
-	if resp.Data.KLines == nil {
-		return nil, fmt.Errorf("no kline data")
-	}
-
-	return resp.Data.KLines, nil
-}
-
 // GetDragonTigerStatus checks if a stock is on the latest Dragon & Tiger list
 func GetDragonTigerStatus(code string) (bool, string, error) {
 	// Clean code (remove sh/sz prefix for EastMoney query if needed, but response has SECURITY_CODE as 6 digits)
@@ -553,51 +492,6 @@ func GetDragonTigerSeats(code, date string) ([]string, []string, error) {
 	return buySeats, sellSeats, nil
 }
 
-// getSecId converts stock code to EastMoney secid format (1.6xxxxx for SH, 0.xxxxxx for SZ/BJ)
-func getSecId(code string) string {
-	cleanCode := code
-	if len(code) > 6 {
-		cleanCode = code[len(code)-6:]
-	}
-	// Simple rule: 6 starts for SH (1), others for SZ/BJ (0)
-	if strings.HasPrefix(cleanCode, "6") {
-		return "1." + cleanCode
-	}
-	return "0." + cleanCode
-}
-
-// GetOrderBook fetches the real-time 5-level order book
-func GetOrderBook(code string) (string, error) {
-	secId := getSecId(code)
-	// f11-f40 covers buy/sell 5 levels prices and volumes
-	url := fmt.Sprintf("http://push2.eastmoney.com/api/qt/stock/get?fltt=2&invt=2&klt=101&secid=%s&fields=f19,f20,f17,f18,f15,f16,f13,f14,f11,f12,f39,f40,f37,f38,f35,f36,f33,f34,f31,f32,f191,f192", secId)
-
-	var resp OrderBookResponse
-	if err := fetchJSON(url, &resp); err != nil {
-		return "", err
-	}
-
-	// Format output
-	var sb strings.Builder
-
-	// Add WeiBi/WeiCha if available
-	if resp.Data.WeiBi != nil && resp.Data.WeiCha != nil {
-		sb.WriteString(fmt.Sprintf("Intraday Pressure: WeiBi(%.2f%%), WeiCha(%.0f) | ", resp.Data.WeiBi, resp.Data.WeiCha))
-	}
-
-	// Add Order Book Snapshot
-	// Check if data is valid (sometimes returns 0s if market closed or data missing)
-	if resp.Data.Buy1Price > 0 || resp.Data.Sell1Price > 0 {
-		sb.WriteString(fmt.Sprintf("Buy1: %.2f(%d), Sell1: %.2f(%d)",
-			resp.Data.Buy1Price, resp.Data.Buy1Vol,
-			resp.Data.Sell1Price, resp.Data.Sell1Vol))
-	} else {
-		sb.WriteString("Order Book Snapshot: Unavailable (Market Closed or Level-1 Restricted)")
-	}
-
-	return sb.String(), nil
-}
-
 // GetChipDistribution fetches the chip distribution (cost concentration)
 func GetChipDistribution(code string) (string, error) {
 	cleanCode := code
@@ -625,8 +519,11 @@ func GetChipDistribution(code string) (string, error) {
 
 // GetIndustryIndex fetches the industry sector info
 func GetIndustryIndex(code string) (string, error) {
-	secId := getSecId(code)
-	url := fmt.Sprintf("http://push2.eastmoney.com/api/qt/stock/get?secid=%s&fields=f127,f128,f129", secId)
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf("http://push2.eastmoney.com/api/qt/stock/get?secid=%s&fields=f127,f128,f129", sec.SecID())
 
 	var resp IndustryResponse
 	if err := fetchJSON(url, &resp); err != nil {
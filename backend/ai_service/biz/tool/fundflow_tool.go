@@ -0,0 +1,104 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+)
+
+// fundFlowDays is the history the tool asks for, enough for the 20-day accumulation
+const fundFlowDays = 20
+
+type FundFlowTool struct {
+	Client stockservice.Client
+}
+
+func NewFundFlowTool(client stockservice.Client) *FundFlowTool {
+	return &FundFlowTool{Client: client}
+}
+
+func (t *FundFlowTool) Name() string {
+	return "StockFundFlow"
+}
+
+func (t *FundFlowTool) Description() string {
+	return "Useful for checking the main capital flow (主力资金流向) of a stock: daily net inflow of super-large/large/medium/small orders over the last 20 sessions, multi-day accumulation and today's intraday flow. Input should be the stock code (e.g., sh600519)."
+}
+
+func (t *FundFlowTool) Call(ctx context.Context, input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if idx := strings.IndexAny(input, " \r\n"); idx != -1 {
+		input = input[:idx]
+	}
+	log.Printf("FundFlowTool called with input: [%s]\n", input)
+
+	resp, err := t.Client.GetStockFundFlow(ctx, &stock.GetStockFundFlowRequest{Code: input, Days: fundFlowDays})
+	if err != nil {
+		log.Printf("FundFlowTool GetStockFundFlow error: %v\n", err)
+		return fmt.Sprintf("Error fetching fund flow: %v", err), nil
+	}
+	return SummarizeFundFlow(resp), nil
+}
+
+// SummarizeFundFlow describes the money flow for the prompt: main net inflow accumulated
+// over 3/5/10/20 sessions, the current inflow/outflow streak, the last 5 sessions by
+// order size and where today's intraday flow stands. Amounts are in 亿 CNY.
+func SummarizeFundFlow(resp *stock.GetStockFundFlowResponse) string {
+	days := resp.Days
+	if len(days) == 0 {
+		return "Fund Flow: No data"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Fund Flow of %s (%s), %d sessions up to %s\n", resp.Name, resp.Code, len(days), days[len(days)-1].Date))
+
+	var windows []string
+	for _, n := range []int{3, 5, 10, 20} {
+		if n > len(days) {
+			break
+		}
+		var sum float64
+		for _, d := range days[len(days)-n:] {
+			sum += d.MainNet
+		}
+		windows = append(windows, fmt.Sprintf("%dd %+.2f亿", n, sum/1e8))
+	}
+	sb.WriteString("Main net inflow accumulated: " + strings.Join(windows, ", ") + "\n")
+
+	streak := 0
+	inflow := days[len(days)-1].MainNet >= 0
+	for i := len(days) - 1; i >= 0 && (days[i].MainNet >= 0) == inflow; i-- {
+		streak++
+	}
+	direction := "outflow"
+	if inflow {
+		direction = "inflow"
+	}
+	sb.WriteString(fmt.Sprintf("Streak: main %s for %d consecutive sessions\n", direction, streak))
+
+	sb.WriteString("Recent sessions (Main / SuperLarge / Large / Medium / Small):\n")
+	start := len(days) - 5
+	if start < 0 {
+		start = 0
+	}
+	for _, d := range days[start:] {
+		sb.WriteString(fmt.Sprintf("- %s: %+.2f / %+.2f / %+.2f / %+.2f / %+.2f亿 (%+.2f%% of turnover), Close %.2f (%+.2f%%)\n",
+			d.Date, d.MainNet/1e8, d.SuperLargeNet/1e8, d.LargeNet/1e8, d.MediumNet/1e8, d.SmallNet/1e8, d.MainNetRatio, d.Close, d.ChangePercent))
+	}
+
+	if n := len(resp.Intraday); n > 0 {
+		last := resp.Intraday[n-1]
+		sb.WriteString(fmt.Sprintf("Intraday %s at %s: main %+.2f亿 (SuperLarge %+.2f, Large %+.2f)",
+			resp.IntradayDate, last.Time, last.MainNet/1e8, last.SuperLargeNet/1e8, last.LargeNet/1e8))
+		if n > 30 {
+			ref := resp.Intraday[n-31]
+			sb.WriteString(fmt.Sprintf(", last 30min %+.2f亿", (last.MainNet-ref.MainNet)/1e8))
+		}
+		sb.WriteString("\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
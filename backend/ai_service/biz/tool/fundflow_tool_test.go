@@ -0,0 +1,37 @@
+package tool
+
+import (
+	"testing"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeFundFlow(t *testing.T) {
+	resp := &stock.GetStockFundFlowResponse{
+		Code: "sh600519",
+		Name: "贵州茅台",
+		Days: []*stock.FundFlowDay{
+			{Date: "2024-05-13", MainNet: -50000000},
+			{Date: "2024-05-14", MainNet: 100000000},
+			{Date: "2024-05-15", MainNet: 200000000},
+			{Date: "2024-05-16", MainNet: 50000000, SuperLargeNet: 80000000, LargeNet: -30000000, Close: 1700, ChangePercent: 0.59},
+		},
+		IntradayDate: "2024-05-17",
+		Intraday: []*stock.FundFlowMinute{
+			{Time: "09:31", MainNet: 10000000},
+			{Time: "09:32", MainNet: -20000000, SuperLargeNet: -5000000, LargeNet: -15000000},
+		},
+	}
+
+	summary := SummarizeFundFlow(resp)
+	assert.Contains(t, summary, "4 sessions up to 2024-05-16")
+	assert.Contains(t, summary, "Main net inflow accumulated: 3d +3.50亿")
+	assert.NotContains(t, summary, "5d")
+	assert.Contains(t, summary, "main inflow for 3 consecutive sessions")
+	assert.Contains(t, summary, "- 2024-05-16: +0.50 / +0.80 / -0.30")
+	assert.Contains(t, summary, "Intraday 2024-05-17 at 09:32: main -0.20亿 (SuperLarge -0.05, Large -0.15)")
+
+	assert.Equal(t, "Fund Flow: No data", SummarizeFundFlow(&stock.GetStockFundFlowResponse{}))
+}
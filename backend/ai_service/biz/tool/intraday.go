@@ -0,0 +1,69 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+)
+
+// GetIntradaySummary fetches today's minute line (分时) and condenses it for the prompt
+func GetIntradaySummary(ctx context.Context, client stockservice.Client, code string) (string, error) {
+	resp, err := client.GetIntradayTrend(ctx, &stock.GetIntradayTrendRequest{Code: code})
+	if err != nil {
+		return "", err
+	}
+	return SummarizeIntraday(resp), nil
+}
+
+// SummarizeIntraday describes the session so far: range, position against the
+// average price line (均价线), volume distribution and the last half hour momentum.
+func SummarizeIntraday(trend *stock.GetIntradayTrendResponse) string {
+	points := trend.Points
+	if len(points) == 0 {
+		return "Intraday Trend: No minute data yet"
+	}
+
+	pct := func(price float64) float64 {
+		if trend.PrevClose <= 0 {
+			return 0
+		}
+		return (price - trend.PrevClose) / trend.PrevClose * 100
+	}
+
+	first, last := points[0], points[len(points)-1]
+	high, low := first, first
+	var totalVol, firstHalfHourVol int64
+	aboveAvg := 0
+	for i, p := range points {
+		if p.Price > high.Price {
+			high = p
+		}
+		if p.Price < low.Price {
+			low = p
+		}
+		totalVol += p.Volume
+		if i < 30 {
+			firstHalfHourVol += p.Volume
+		}
+		if p.Price >= p.AvgPrice {
+			aboveAvg++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Date: %s, PrevClose: %.2f, Minutes: %d\n", trend.Date, trend.PrevClose, len(points)))
+	sb.WriteString(fmt.Sprintf("Open: %.2f (%.2f%%), Now: %.2f (%.2f%%) at %s\n", first.Price, pct(first.Price), last.Price, pct(last.Price), last.Time))
+	sb.WriteString(fmt.Sprintf("High: %.2f (%.2f%%) at %s, Low: %.2f (%.2f%%) at %s\n", high.Price, pct(high.Price), high.Time, low.Price, pct(low.Price), low.Time))
+	sb.WriteString(fmt.Sprintf("AvgPrice(均价): %.2f, Price above AvgPrice in %d/%d minutes\n", last.AvgPrice, aboveAvg, len(points)))
+	if totalVol > 0 {
+		sb.WriteString(fmt.Sprintf("First 30min volume share: %.1f%%\n", float64(firstHalfHourVol)/float64(totalVol)*100))
+	}
+	if len(points) > 30 {
+		ref := points[len(points)-31]
+		sb.WriteString(fmt.Sprintf("Last 30min: %.2f -> %.2f (%.2f%%)", ref.Price, last.Price, (last.Price-ref.Price)/ref.Price*100))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
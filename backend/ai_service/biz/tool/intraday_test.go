@@ -0,0 +1,28 @@
+package tool
+
+import (
+	"testing"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeIntraday(t *testing.T) {
+	trend := &stock.GetIntradayTrendResponse{
+		Date:      "2024-05-15",
+		PrevClose: 10.00,
+		Points: []*stock.IntradayPoint{
+			{Time: "09:30", Price: 10.10, AvgPrice: 10.10, Volume: 1000},
+			{Time: "09:31", Price: 9.90, AvgPrice: 10.00, Volume: 500},
+			{Time: "09:32", Price: 10.50, AvgPrice: 10.20, Volume: 500},
+		},
+	}
+
+	summary := SummarizeIntraday(trend)
+	assert.Contains(t, summary, "Now: 10.50 (5.00%) at 09:32")
+	assert.Contains(t, summary, "High: 10.50 (5.00%) at 09:32, Low: 9.90 (-1.00%) at 09:31")
+	assert.Contains(t, summary, "in 2/3 minutes")
+
+	assert.Equal(t, "Intraday Trend: No minute data yet", SummarizeIntraday(&stock.GetIntradayTrendResponse{}))
+}
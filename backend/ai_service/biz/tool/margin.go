@@ -0,0 +1,54 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+)
+
+// marginDays is the margin history the analysis looks at
+const marginDays = 10
+
+// GetMarginSummary fetches the margin balances (融资融券) of a stock and condenses them for the prompt
+func GetMarginSummary(ctx context.Context, client stockservice.Client, code string) (string, error) {
+	resp, err := client.GetMarginTrading(ctx, &stock.GetMarginTradingRequest{Code: code, Days: marginDays})
+	if err != nil {
+		return "", err
+	}
+	return SummarizeMargin(resp), nil
+}
+
+// SummarizeMargin describes the leverage on a stock: the latest balances, how the financing
+// balance moved over the window and in how many sessions it rose, then the daily detail.
+// Amounts are in 亿 CNY.
+func SummarizeMargin(resp *stock.GetMarginTradingResponse) string {
+	days := resp.Days
+	if len(days) == 0 {
+		return "Margin Trading: No data"
+	}
+
+	first, last := days[0], days[len(days)-1]
+	var change float64
+	rising := 0
+	for _, d := range days {
+		change += d.FinancingBalanceChange
+		if d.FinancingBalanceChange > 0 {
+			rising++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Margin balances on %s: Financing %.2f亿, Lending %.2f亿, Total %.2f亿\n",
+		last.Date, last.FinancingBalance/1e8, last.LendingBalance/1e8, last.TotalBalance/1e8))
+	sb.WriteString(fmt.Sprintf("Financing balance since %s: %+.2f亿, rising in %d/%d sessions\n",
+		first.Date, change/1e8, rising, len(days)))
+	for _, d := range days {
+		sb.WriteString(fmt.Sprintf("- %s: Financing %.2f亿 (%+.2f), Buy %.2f / Repay %.2f亿, Lending %.2f亿 (%+.2f), Close %.2f (%+.2f%%)\n",
+			d.Date, d.FinancingBalance/1e8, d.FinancingBalanceChange/1e8, d.FinancingBuy/1e8, d.FinancingRepay/1e8,
+			d.LendingBalance/1e8, d.LendingBalanceChange/1e8, d.Close, d.ChangePercent))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
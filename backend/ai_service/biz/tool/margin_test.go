@@ -0,0 +1,27 @@
+package tool
+
+import (
+	"testing"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeMargin(t *testing.T) {
+	resp := &stock.GetMarginTradingResponse{
+		Code: "sh600519",
+		Days: []*stock.MarginDay{
+			{Date: "2024-05-14", FinancingBalance: 1.5e10, FinancingBalanceChange: 2e8},
+			{Date: "2024-05-15", FinancingBalance: 1.49e10, FinancingBalanceChange: -1e8},
+			{Date: "2024-05-16", FinancingBalance: 1.52e10, FinancingBalanceChange: 3e8, LendingBalance: 5e7, TotalBalance: 1.525e10},
+		},
+	}
+
+	summary := SummarizeMargin(resp)
+	assert.Contains(t, summary, "Margin balances on 2024-05-16: Financing 152.00亿, Lending 0.50亿, Total 152.50亿")
+	assert.Contains(t, summary, "Financing balance since 2024-05-14: +4.00亿, rising in 2/3 sessions")
+	assert.Contains(t, summary, "- 2024-05-15: Financing 149.00亿 (-1.00)")
+
+	assert.Equal(t, "Margin Trading: No data", SummarizeMargin(&stock.GetMarginTradingResponse{}))
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"sort"
 	"strings"
-	"time"
 
 	"stock_assistant/backend/ai_service/biz/tool/eastmoney"
+	"stock_assistant/backend/common/calendar"
 )
 
 type MarketInfoTool struct {
@@ -37,14 +37,11 @@ func (t *DragonTigerTool) Name() string {
 }
 
 func (t *DragonTigerTool) Description() string {
-	return "Get daily Dragon Tiger List (Longhu Bang) data. Input can be a date (YYYY-MM-DD) or empty for today."
+	return "Get daily Dragon Tiger List (Longhu Bang) data. Input can be a date (YYYY-MM-DD) or empty for the latest trading day."
 }
 
 func (t *DragonTigerTool) Call(ctx context.Context, input string) (string, error) {
-	date := strings.TrimSpace(input)
-	if date == "" {
-		date = time.Now().Format("2006-01-02")
-	}
+	date := calendar.DefaultDate(strings.TrimSpace(input))
 
 	items, err := t.EastMoneyClient.GetDragonTigerList(ctx, date)
 	if err != nil {
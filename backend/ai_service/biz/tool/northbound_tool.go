@@ -0,0 +1,116 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+)
+
+// northboundDays is the history the tool asks for
+const northboundDays = 10
+
+type NorthboundTool struct {
+	Client stockservice.Client
+}
+
+func NewNorthboundTool(client stockservice.Client) *NorthboundTool {
+	return &NorthboundTool{Client: client}
+}
+
+func (t *NorthboundTool) Name() string {
+	return "NorthboundFlow"
+}
+
+func (t *NorthboundTool) Description() string {
+	return "Useful for checking northbound capital (北向资金, 沪股通/深股通). Input 'market' (or empty) returns the daily and intraday northbound net buy and today's top 10 traded stocks; input a stock code (e.g., sh600519) returns how northbound holdings of that stock changed over the last 10 sessions."
+}
+
+func (t *NorthboundTool) Call(ctx context.Context, input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if idx := strings.IndexAny(input, " \r\n"); idx != -1 {
+		input = input[:idx]
+	}
+	log.Printf("NorthboundTool called with input: [%s]\n", input)
+
+	if input == "" || strings.EqualFold(input, "market") {
+		flow, err := t.Client.GetNorthboundFlow(ctx, &stock.GetNorthboundFlowRequest{Days: northboundDays})
+		if err != nil {
+			log.Printf("NorthboundTool GetNorthboundFlow error: %v\n", err)
+			return fmt.Sprintf("Error fetching northbound flow: %v", err), nil
+		}
+		top, err := t.Client.GetNorthboundTopStocks(ctx, &stock.GetNorthboundTopStocksRequest{})
+		if err != nil {
+			log.Printf("NorthboundTool GetNorthboundTopStocks error: %v\n", err)
+			top = &stock.GetNorthboundTopStocksResponse{}
+		}
+		return SummarizeNorthbound(flow, top), nil
+	}
+
+	resp, err := t.Client.GetNorthboundHolding(ctx, &stock.GetNorthboundHoldingRequest{Code: input, Days: northboundDays})
+	if err != nil {
+		log.Printf("NorthboundTool GetNorthboundHolding error: %v\n", err)
+		return fmt.Sprintf("Error fetching northbound holding: %v", err), nil
+	}
+	return SummarizeNorthboundHolding(resp), nil
+}
+
+// SummarizeNorthbound describes the market-wide northbound flow: the daily net buy
+// by connect, where today's intraday flow stands and the top traded stocks.
+// Amounts are in 亿 CNY.
+func SummarizeNorthbound(flow *stock.GetNorthboundFlowResponse, top *stock.GetNorthboundTopStocksResponse) string {
+	var sb strings.Builder
+	if flow == nil || len(flow.Days) == 0 {
+		sb.WriteString("Northbound daily flow: No data\n")
+	} else {
+		var total float64
+		sb.WriteString("Northbound daily net buy (Total / 沪股通 / 深股通, turnover):\n")
+		for _, d := range flow.Days {
+			total += d.NetBuy
+			sb.WriteString(fmt.Sprintf("- %s: %+.2f / %+.2f / %+.2f亿, Turnover %.0f亿\n",
+				d.Date, d.NetBuy/1e8, d.ShNetBuy/1e8, d.SzNetBuy/1e8, d.DealAmount/1e8))
+		}
+		sb.WriteString(fmt.Sprintf("Accumulated over %d sessions: %+.2f亿\n", len(flow.Days), total/1e8))
+	}
+	if flow != nil && len(flow.Intraday) > 0 {
+		last := flow.Intraday[len(flow.Intraday)-1]
+		sb.WriteString(fmt.Sprintf("Intraday %s at %s: %+.2f亿 (沪股通 %+.2f, 深股通 %+.2f)\n",
+			flow.IntradayDate, last.Time, last.NetBuy/1e8, last.ShNetBuy/1e8, last.SzNetBuy/1e8))
+	}
+
+	if top != nil && len(top.Stocks) > 0 {
+		sb.WriteString(fmt.Sprintf("Top traded through the connects on %s:\n", top.Date))
+		for _, s := range top.Stocks {
+			sb.WriteString(fmt.Sprintf("- [%s #%d] %s(%s): %+.2f%%, Net Buy %+.2f亿, Turnover %.2f亿\n",
+				s.Connect, s.Rank, s.Name, s.Code, s.ChangePercent, s.NetBuy/1e8, s.DealAmount/1e8))
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// SummarizeNorthboundHolding describes how the northbound holding of one stock changed
+func SummarizeNorthboundHolding(resp *stock.GetNorthboundHoldingResponse) string {
+	holdings := resp.Holdings
+	if len(holdings) == 0 {
+		return "Northbound holding: No data"
+	}
+
+	first, last := holdings[0], holdings[len(holdings)-1]
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Northbound holding of %s (%s) on %s: %.0f shares, %.2f亿, %.2f%% of float\n",
+		resp.Name, resp.Code, last.Date, last.Shares, last.MarketCap/1e8, last.FloatRatio))
+	var change float64
+	for _, h := range holdings {
+		change += h.SharesChange
+	}
+	sb.WriteString(fmt.Sprintf("Over %d sessions: %+.0f shares, float share %.2f%% -> %.2f%%\n",
+		len(holdings), change, first.FloatRatio, last.FloatRatio))
+	for _, h := range holdings {
+		sb.WriteString(fmt.Sprintf("- %s: %+.0f shares, %.2f%% of float, Close %.2f (%+.2f%%)\n",
+			h.Date, h.SharesChange, h.FloatRatio, h.Close, h.ChangePercent))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,54 @@
+package tool
+
+import (
+	"testing"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeNorthbound(t *testing.T) {
+	flow := &stock.GetNorthboundFlowResponse{
+		Days: []*stock.NorthboundDay{
+			{Date: "2024-05-14", NetBuy: 3e9, ShNetBuy: 2e9, SzNetBuy: 1e9, DealAmount: 1.2e11},
+			{Date: "2024-05-15", NetBuy: -1e9, ShNetBuy: -1.5e9, SzNetBuy: 5e8, DealAmount: 1e11},
+		},
+		IntradayDate: "2024-05-16",
+		Intraday: []*stock.NorthboundMinute{
+			{Time: "09:31", NetBuy: 1e8},
+			{Time: "09:32", NetBuy: 2.5e8, ShNetBuy: 2e8, SzNetBuy: 5e7},
+		},
+	}
+	top := &stock.GetNorthboundTopStocksResponse{
+		Date: "2024-05-15",
+		Stocks: []*stock.NorthboundTopStock{
+			{Connect: "sh", Rank: 1, Code: "600519", Name: "贵州茅台", ChangePercent: 0.59, NetBuy: 3e8, DealAmount: 2.5e9},
+		},
+	}
+
+	summary := SummarizeNorthbound(flow, top)
+	assert.Contains(t, summary, "- 2024-05-15: -10.00 / -15.00 / +5.00亿, Turnover 1000亿")
+	assert.Contains(t, summary, "Accumulated over 2 sessions: +20.00亿")
+	assert.Contains(t, summary, "Intraday 2024-05-16 at 09:32: +2.50亿 (沪股通 +2.00, 深股通 +0.50)")
+	assert.Contains(t, summary, "- [sh #1] 贵州茅台(600519): +0.59%, Net Buy +3.00亿")
+
+	assert.Equal(t, "Northbound daily flow: No data", SummarizeNorthbound(nil, nil))
+}
+
+func TestSummarizeNorthboundHolding(t *testing.T) {
+	resp := &stock.GetNorthboundHoldingResponse{
+		Code: "sh600519",
+		Name: "贵州茅台",
+		Holdings: []*stock.NorthboundHolding{
+			{Date: "2024-05-14", Shares: 1000000, SharesChange: 20000, FloatRatio: 7.10},
+			{Date: "2024-05-15", Shares: 1050000, SharesChange: 50000, FloatRatio: 7.15, MarketCap: 1.8e9},
+		},
+	}
+
+	summary := SummarizeNorthboundHolding(resp)
+	assert.Contains(t, summary, "on 2024-05-15: 1050000 shares, 18.00亿, 7.15% of float")
+	assert.Contains(t, summary, "Over 2 sessions: +70000 shares, float share 7.10% -> 7.15%")
+
+	assert.Equal(t, "Northbound holding: No data", SummarizeNorthboundHolding(&stock.GetNorthboundHoldingResponse{}))
+}
@@ -1,43 +1,48 @@
 package tool
 
 import (
+	"context"
 	"fmt"
-	"strconv"
 	"strings"
+	"time"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/common/symbol"
 )
 
 // CheckRiskControlRules checks for severe abnormal fluctuations
-func CheckRiskControlRules(code string) string {
-	var benchmarkSecId string
-	var boardName string
-
-	// Clean code
-	cleanCode := code
-	if len(code) > 6 {
-		cleanCode = code[len(code)-6:]
+func CheckRiskControlRules(ctx context.Context, client stockservice.Client, code string) string {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return fmt.Sprintf("无法进行量化风控检查: %v", err)
 	}
 
-	if strings.HasPrefix(cleanCode, "688") {
-		benchmarkSecId = "1.000688" // STAR 50
+	var benchmarkCode string
+	var boardName string
+	switch {
+	case sec.Board == symbol.BoardSTAR:
+		benchmarkCode = "sh000688" // STAR 50
 		boardName = "科创板"
-	} else if strings.HasPrefix(cleanCode, "6") {
-		benchmarkSecId = "1.000001" // SSE Composite
-		boardName = "沪市主板"
-	} else if strings.HasPrefix(cleanCode, "3") {
-		benchmarkSecId = "0.399006" // ChiNext
+	case sec.Board == symbol.BoardChiNext:
+		benchmarkCode = "sz399006" // ChiNext
 		boardName = "创业板"
-	} else if strings.HasPrefix(cleanCode, "8") || strings.HasPrefix(cleanCode, "4") || strings.HasPrefix(cleanCode, "9") {
-		benchmarkSecId = "0.899050" // BSE 50 (Best guess)
+	case sec.Board == symbol.BoardBSE:
+		benchmarkCode = "bj899050" // BSE 50 (Best guess)
 		boardName = "北交所"
-	} else {
-		benchmarkSecId = "0.399107" // SZSE A Share
+	case sec.Exchange == symbol.SSE:
+		benchmarkCode = "sh000001" // SSE Composite
+		boardName = "沪市主板"
+	default:
+		benchmarkCode = "sz399107" // SZSE A Share
 		boardName = "深市主板"
 	}
 
 	// Fetch data (60 days for stock to ensure enough history for 30-day calc, 60 for benchmark)
 	// We need index 30 (31st data point) for 30-day deviation check
-	stockK, err1 := GetKLineData(cleanCode, 60)
-	benchK, err2 := GetKLineData(benchmarkSecId, 60)
+	stockK, err1 := getDailyBars(ctx, client, sec.Prefixed(), 60)
+	benchK, err2 := getDailyBars(ctx, client, benchmarkCode, 60)
 
 	if err1 != nil || err2 != nil {
 		return fmt.Sprintf("无法进行量化风控检查: 数据获取失败 (StockErr: %v, BenchErr: %v)", err1, err2)
@@ -45,27 +50,21 @@ func CheckRiskControlRules(code string) string {
 
 	// Map benchmark data by date (Close Price for interval calc)
 	benchCloseMap := make(map[string]float64)
-	for _, line := range benchK {
-		parts := strings.Split(line, ",")
-		if len(parts) > 1 {
-			// Format: Date, Close, Volume, ChangePct
-			val, _ := strconv.ParseFloat(parts[1], 64)
-			benchCloseMap[parts[0]] = val
-		}
+	for _, bar := range benchK {
+		benchCloseMap[bar.Date] = bar.Close
 	}
 
-	// Filter valid trading days from stockK (reverse order: latest to oldest)
-	var validStockK []string
+	// Filter valid trading days from stockK (reverse order: latest to oldest).
+	// Only exchange sessions count, and a zero-volume bar on a session is a
+	// suspension day, which the abnormal fluctuation rules skip as well.
+	var validStockK []*stock.KLineBar
 	for i := len(stockK) - 1; i >= 0; i-- {
-		parts := strings.Split(stockK[i], ",")
-		if len(parts) <= 3 {
+		bar := stockK[i]
+		day, err := time.ParseInLocation(calendar.DateLayout, bar.Date, calendar.Location)
+		if err != nil || !calendar.IsTradingDay(day) || bar.Volume == 0 {
 			continue
 		}
-		// Check for non-trading days (Volume = 0)
-		vol, _ := strconv.ParseFloat(parts[2], 64)
-		if vol > 0 {
-			validStockK = append(validStockK, stockK[i])
-		}
+		validStockK = append(validStockK, bar)
 	}
 
 	// Helper to calculate interval deviation
@@ -76,9 +75,8 @@ func CheckRiskControlRules(code string) string {
 		}
 
 		// End Date Data (Latest)
-		endParts := strings.Split(validStockK[0], ",")
-		endClose, _ := strconv.ParseFloat(endParts[1], 64)
-		endDate := endParts[0]
+		endClose := validStockK[0].Close
+		endDate := validStockK[0].Date
 
 		// Start Date Data (The day BEFORE the interval starts)
 		// For 10 days interval, we need the closing price of the 11th day back as base
@@ -88,15 +86,14 @@ func CheckRiskControlRules(code string) string {
 			fmt.Printf("[RiskControl] Warning: Data length (%d) <= days (%d), using oldest available day index %d\n", len(validStockK), days, actualDays)
 		}
 
-		startParts := strings.Split(validStockK[actualDays], ",")
-		startClose, _ := strconv.ParseFloat(startParts[1], 64)
-		baseDate := startParts[0]
+		startClose := validStockK[actualDays].Close
+		baseDate := validStockK[actualDays].Date
 
 		// Get Interval Start Date (T-(days-1)) for display clarity
 		// This is the first day INCLUDED in the interval
 		intervalStartDate := "N/A"
 		if actualDays > 0 {
-			intervalStartDate = strings.Split(validStockK[actualDays-1], ",")[0]
+			intervalStartDate = validStockK[actualDays-1].Date
 		}
 
 		// Calculate Stock Interval Pct
@@ -158,3 +155,20 @@ func CheckRiskControlRules(code string) string {
 	}
 	return fmt.Sprintf("✅ 偏离值检查通过 (%s): 10日偏离 %.2f%%, 30日偏离 %.2f%%", boardName, dev10, dev30)
 }
+
+// getDailyBars fetches the last n forward-adjusted daily bars through stock_service
+func getDailyBars(ctx context.Context, client stockservice.Client, code string, n int) ([]*stock.KLineBar, error) {
+	resp, err := client.GetKLine(ctx, &stock.GetKLineRequest{
+		Code:   code,
+		Period: "day",
+		Adjust: "forward",
+		Limit:  int32(n),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Bars) == 0 {
+		return nil, fmt.Errorf("no kline data")
+	}
+	return resp.Bars, nil
+}
@@ -9,6 +9,7 @@ import (
 	"stock_assistant/backend/ai_service/biz/tool/eastmoney"
 	"stock_assistant/backend/ai_service/kitex_gen/stock"
 	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+	"stock_assistant/backend/common/symbol"
 )
 
 type SectorTool struct {
@@ -108,8 +109,10 @@ func (t *SectorTool) selectLeaders(candidates []*eastmoney.SectorStockItem) []*e
 			break
 		}
 		
-		isSH := strings.HasPrefix(item.Code, "6")
-		isSZ := strings.HasPrefix(item.Code, "0") || strings.HasPrefix(item.Code, "3")
+		// Beijing and unknown codes are never picked, the quota is split between SH and SZ
+		sec, err := symbol.Parse(item.Code)
+		isSH := err == nil && sec.Type == symbol.TypeStock && sec.Exchange == symbol.SSE
+		isSZ := err == nil && sec.Type == symbol.TypeStock && sec.Exchange == symbol.SZSE
 		
 		canPick := true
 		if isSH {
@@ -154,35 +157,60 @@ func (t *SectorTool) getSectorRank(ctx context.Context, rankType string) (string
 }
 
 func (t *SectorTool) getLimitUpPool(ctx context.Context) (string, error) {
-	req := &stock.GetLimitUpPoolRequest{}
-	resp, err := t.Client.GetLimitUpPool(ctx, req)
+	resp, err := t.Client.GetLimitUpLadder(ctx, &stock.GetLimitUpLadderRequest{})
 	if err != nil {
-		log.Printf("SectorTool GetLimitUpPool error: %v", err)
+		log.Printf("SectorTool GetLimitUpLadder error: %v", err)
 		return fmt.Sprintf("Error fetching limit-up data: %v", err), nil
 	}
 
+	total := 0
+	for _, l := range resp.Levels {
+		total += len(l.Stocks)
+	}
+
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("Limit-Up Pool Summary (Total: %d):\n", len(resp.Stocks)))
-	
-	// Group by height (LimitUpType)
-	groups := make(map[string][]string)
-	for _, s := range resp.Stocks {
-		groups[s.LimitUpType] = append(groups[s.LimitUpType], fmt.Sprintf("%s(%s)", s.Name, s.Reason))
-	}
-
-	// Sort order: usually by board count desc, but map is unordered.
-	// We just iterate common keys for display
-	keys := []string{"5连板", "4连板", "3连板", "2连板", "首板"}
-	for _, k := range keys {
-		if stocks, ok := groups[k]; ok {
-			sb.WriteString(fmt.Sprintf("[%s]: %s\n", k, strings.Join(stocks, ", ")))
-			delete(groups, k)
-		}
+	sb.WriteString(fmt.Sprintf("Limit-Up Pool Summary %s (Total: %d):\n", resp.Date, total))
+	snap, err := t.Client.GetSentimentSnapshot(ctx, &stock.GetSentimentSnapshotRequest{Date: resp.Date})
+	if err != nil {
+		log.Printf("SectorTool GetSentimentSnapshot error: %v", err)
+	} else if s := snap.Snapshot; s != nil {
+		sb.WriteString(fmt.Sprintf("Broken: %d (炸板率 %.2f%%), Limit-Down: %d, Highest Board: %d\n",
+			s.BrokenCount, s.BrokenRate, s.LimitDownCount, s.HighestBoard))
 	}
-	// Remaining
-	for k, stocks := range groups {
-		sb.WriteString(fmt.Sprintf("[%s]: %s\n", k, strings.Join(stocks, ", ")))
+
+	// The ladder comes highest board first
+	for _, l := range resp.Levels {
+		entries := make([]string, 0, len(l.Stocks))
+		for _, s := range l.Stocks {
+			theme := s.Reason
+			if s.Concept != "" {
+				theme += "/" + s.Concept
+			}
+			entry := fmt.Sprintf("%s(%s, 封单%.1f亿, 首封%s", s.Name, theme, s.SealAmount/100000000, s.FirstSealTime)
+			if s.OpenCount > 0 {
+				entry += fmt.Sprintf(", 炸板%d次", s.OpenCount)
+			}
+			entries = append(entries, entry+")")
+		}
+
+		header := fmt.Sprintf("[%s]", boardLabel(l.Height))
+		if resp.PrevDate != "" && l.Height > 1 {
+			header += fmt.Sprintf(" 晋级率 %.0f%% (%d/%d)", l.PromotionRate, l.PromotedCount, l.PrevCount)
+		}
+		if len(entries) == 0 {
+			sb.WriteString(header + ": none\n")
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s: %s\n", header, strings.Join(entries, ", ")))
 	}
 
 	return sb.String(), nil
 }
+
+// boardLabel is the usual name of a ladder height, e.g. 首板, 3连板
+func boardLabel(height int32) string {
+	if height <= 1 {
+		return "首板"
+	}
+	return fmt.Sprintf("%d连板", height)
+}
@@ -22,7 +22,7 @@ func (t *StockPriceTool) Name() string {
 }
 
 func (t *StockPriceTool) Description() string {
-	return "Useful for getting the realtime stock price and information. Input should be the stock code (e.g., sh600519)."
+	return "Useful for getting the realtime stock price and information. Input should be the stock code (e.g., sh600519), or several codes separated by commas (e.g., sh600519,sz000001) to fetch them in one call."
 }
 
 func (t *StockPriceTool) Call(ctx context.Context, input string) (string, error) {
@@ -48,6 +48,10 @@ func (t *StockPriceTool) Call(ctx context.Context, input string) (string, error)
 	}, input)
 
 	log.Printf("StockPriceTool called with cleaned input: [%s]\n", input)
+	if strings.Contains(input, ",") {
+		return t.callBatch(ctx, input)
+	}
+
 	req := &stock.GetRealtimeRequest{Code: input}
 	resp, err := t.Client.GetRealtime(ctx, req)
 	if err != nil {
@@ -59,17 +63,53 @@ func (t *StockPriceTool) Call(ctx context.Context, input string) (string, error)
 		log.Printf("StockPriceTool: Stock not found for input: %s\n", input)
 		return "Stock not found", nil
 	}
-	result := fmt.Sprintf("Stock: %s (%s), Price: %.2f, Change: %.2f%%, Volume: %d",
-		resp.Stock.Name, resp.Stock.Code, resp.Stock.CurrentPrice, resp.Stock.ChangePercent, resp.Stock.Volume)
+	result := formatStockInfo(resp.Stock)
 	log.Printf("StockPriceTool success: %s\n", result)
 	return result, nil
 }
 
+// callBatch fetches several comma-separated codes with a single GetRealtimeBatch call
+func (t *StockPriceTool) callBatch(ctx context.Context, input string) (string, error) {
+	var codes []string
+	for _, code := range strings.Split(input, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	resp, err := t.Client.GetRealtimeBatch(ctx, &stock.GetRealtimeBatchRequest{Codes: codes})
+	if err != nil {
+		log.Printf("StockPriceTool GetRealtimeBatch error: %v\n", err)
+		return fmt.Sprintf("Error fetching stock data: %v", err), nil
+	}
+
+	var sb strings.Builder
+	for _, info := range resp.Stocks {
+		sb.WriteString(formatStockInfo(info) + "\n")
+	}
+	for _, code := range codes {
+		if msg, ok := resp.Errors[code]; ok {
+			sb.WriteString(fmt.Sprintf("Stock: %s, Error: %s\n", code, msg))
+		}
+	}
+	if sb.Len() == 0 {
+		return "Stock not found", nil
+	}
+	log.Printf("StockPriceTool batch success: %d/%d codes\n", len(resp.Stocks), len(codes))
+	return strings.TrimSpace(sb.String()), nil
+}
+
+func formatStockInfo(info *stock.StockInfo) string {
+	return fmt.Sprintf("Stock: %s (%s), Price: %.2f, Change: %.2f%%, Volume: %d, Source: %s",
+		info.Name, info.Code, info.CurrentPrice, info.ChangePercent, info.Volume, info.Source)
+}
+
 type StockAnalysisTool struct {
+	Client stockservice.Client
 }
 
-func NewStockAnalysisTool() *StockAnalysisTool {
-	return &StockAnalysisTool{}
+func NewStockAnalysisTool(client stockservice.Client) *StockAnalysisTool {
+	return &StockAnalysisTool{Client: client}
 }
 
 func (t *StockAnalysisTool) Name() string {
@@ -77,7 +117,7 @@ func (t *StockAnalysisTool) Name() string {
 }
 
 func (t *StockAnalysisTool) Description() string {
-	return "Useful for getting advanced stock analysis data including Dragon Tiger List history, Chip Distribution, Order Book, Industry info, Guba Popularity, and Regulatory Notices. Input should be the stock code (e.g., 600519)."
+	return "Useful for getting advanced stock analysis data including Dragon Tiger List history, Chip Distribution, Order Book, Industry info, Guba Popularity, Margin Trading (融资融券), Earnings Calendar (report due date, 业绩预告/快报) and Regulatory Notices. Input should be the stock code (e.g., 600519)."
 }
 
 func (t *StockAnalysisTool) Call(ctx context.Context, input string) (string, error) {
@@ -112,11 +152,13 @@ func (t *StockAnalysisTool) Call(ctx context.Context, input string) (string, err
 		industry = "Error fetching industry info"
 	}
 
-	// 2. Order Book
-	orders, err := GetOrderBook(input)
+	// 2. Order Book (Level-1 quote from stock_service)
+	orders := "Error fetching order book"
+	quote, err := t.Client.GetRealtime(ctx, &stock.GetRealtimeRequest{Code: input})
 	if err != nil {
 		log.Printf("Error fetching order book: %v", err)
-		orders = "Error fetching order book"
+	} else if quote.Stock != nil {
+		orders = formatOrderBook(quote.Stock)
 	}
 
 	// 3. Chip Distribution
@@ -145,8 +187,22 @@ func (t *StockAnalysisTool) Call(ctx context.Context, input string) (string, err
 		log.Printf("Error fetching notices: %v", err)
 	}
 
-	// 8. Quantitative Risk Control (Severe Abnormal Fluctuation)
-	riskCheck := CheckRiskControlRules(input)
+	// 8. Margin Trading (Leverage)
+	margin, err := GetMarginSummary(ctx, t.Client, input)
+	if err != nil {
+		log.Printf("Error fetching margin trading: %v", err)
+		margin = "Error fetching margin trading"
+	}
+
+	// 9. Earnings Calendar (Event Risk)
+	earnings, err := GetEarningsSummary(ctx, t.Client, input)
+	if err != nil {
+		log.Printf("Error fetching earnings calendar: %v", err)
+		earnings = "Error fetching earnings calendar"
+	}
+
+	// 10. Quantitative Risk Control (Severe Abnormal Fluctuation, Restricted Share Unlocks)
+	riskCheck := CheckRiskControlRules(ctx, t.Client, input) + "\n" + CheckUnlockRisk(ctx, t.Client, input)
 
 	// Format output
 	var sb strings.Builder
@@ -167,6 +223,12 @@ func (t *StockAnalysisTool) Call(ctx context.Context, input string) (string, err
 	sb.WriteString("\n\n[Market Sentiment & Funds]\n")
 	sb.WriteString(heat)
 
+	sb.WriteString("\n\n[Margin Trading (Leverage)]\n")
+	sb.WriteString(margin)
+
+	sb.WriteString("\n\n[Earnings (Report Due / Preview)]\n")
+	sb.WriteString(earnings)
+
 	sb.WriteString("\n\n[Dragon Tiger List (Last 5)]\n")
 	if len(lhb) > 0 {
 		for _, l := range lhb {
@@ -187,3 +249,25 @@ func (t *StockAnalysisTool) Call(ctx context.Context, input string) (string, err
 
 	return sb.String(), nil
 }
+
+// formatOrderBook renders the Level-1 snapshot: OHLC, 委比/委差 and the five-level book
+func formatOrderBook(info *stock.StockInfo) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Open: %.2f, High: %.2f, Low: %.2f, PrevClose: %.2f, Amount: %.2f亿\n",
+		info.Open, info.High, info.Low, info.PrevClose, info.Amount/1e8))
+
+	ob := info.OrderBook
+	if ob == nil || len(ob.Bids) == 0 || len(ob.Asks) == 0 || (ob.Bids[0].Price == 0 && ob.Asks[0].Price == 0) {
+		sb.WriteString("Order Book Snapshot: Unavailable (Market Closed or Level-1 Restricted)")
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("Intraday Pressure: WeiBi(%.2f%%), WeiCha(%d手)\n", info.WeiBi, info.WeiCha/100))
+	for i := len(ob.Asks) - 1; i >= 0; i-- {
+		sb.WriteString(fmt.Sprintf("Sell%d: %.2f(%d手)\n", i+1, ob.Asks[i].Price, ob.Asks[i].Volume/100))
+	}
+	for i, l := range ob.Bids {
+		sb.WriteString(fmt.Sprintf("Buy%d: %.2f(%d手)\n", i+1, l.Price, l.Volume/100))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,55 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+	"stock_assistant/backend/common/calendar"
+)
+
+// unlockRiskDays is how far ahead restricted share unlocks are flagged
+const unlockRiskDays = 30
+
+// CheckUnlockRisk checks for restricted share unlocks (限售解禁) within the next 30 days
+func CheckUnlockRisk(ctx context.Context, client stockservice.Client, code string) string {
+	today := calendar.Now()
+	resp, err := client.GetShareUnlocks(ctx, &stock.GetShareUnlocksRequest{
+		Code:  code,
+		Start: today.Format(calendar.DateLayout),
+		End:   today.AddDate(0, 0, unlockRiskDays).Format(calendar.DateLayout),
+	})
+	if err != nil {
+		return fmt.Sprintf("无法进行限售解禁检查: %v", err)
+	}
+	return SummarizeUnlockRisk(resp.Unlocks, today)
+}
+
+// SummarizeUnlockRisk flags every unlock within the next 30 days, e.g.
+// "⚠️ 限售解禁预警: 2024-04-22 (5天后) 解禁 1.20亿股, 市值 35.00亿, 占流通股 8.50% (首发原股东限售股份)"
+func SummarizeUnlockRisk(unlocks []*stock.ShareUnlock, today time.Time) string {
+	var risks []string
+	for _, u := range unlocks {
+		days, ok := calendarDays(u.Date, today)
+		if !ok || days < 0 || days > unlockRiskDays {
+			continue
+		}
+		risks = append(risks, fmt.Sprintf("⚠️ 限售解禁预警: %s (%d天后) 解禁 %s, 市值 %.2f亿, 占流通股 %.2f%% (%s)",
+			u.Date, days, formatShares(u.Shares), u.MarketCap/1e8, u.FloatRatio, u.HolderType))
+	}
+	if len(risks) > 0 {
+		return strings.Join(risks, "\n")
+	}
+	return fmt.Sprintf("✅ 未来%d日无限售解禁", unlockRiskDays)
+}
+
+// formatShares formats a share count in 亿股 or, below that, 万股
+func formatShares(shares float64) string {
+	if shares >= 1e8 {
+		return fmt.Sprintf("%.2f亿股", shares/1e8)
+	}
+	return fmt.Sprintf("%.2f万股", shares/1e4)
+}
@@ -0,0 +1,27 @@
+package tool
+
+import (
+	"testing"
+	"time"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeUnlockRisk(t *testing.T) {
+	today := time.Date(2024, 4, 17, 10, 0, 0, 0, time.UTC)
+	unlocks := []*stock.ShareUnlock{
+		{Date: "2024-04-10", Shares: 5e7}, // Already unlocked
+		{Date: "2024-04-22", Shares: 1.2e8, MarketCap: 3.5e9, FloatRatio: 8.5, HolderType: "首发原股东限售股份"},
+		{Date: "2024-05-06", Shares: 3e6, MarketCap: 6e7, FloatRatio: 0.21, HolderType: "股权激励限售股份"},
+		{Date: "2024-06-20", Shares: 2e8}, // Beyond 30 days
+	}
+
+	summary := SummarizeUnlockRisk(unlocks, today)
+	assert.Equal(t, "⚠️ 限售解禁预警: 2024-04-22 (5天后) 解禁 1.20亿股, 市值 35.00亿, 占流通股 8.50% (首发原股东限售股份)\n"+
+		"⚠️ 限售解禁预警: 2024-05-06 (19天后) 解禁 300.00万股, 市值 0.60亿, 占流通股 0.21% (股权激励限售股份)", summary)
+
+	assert.Equal(t, "✅ 未来30日无限售解禁", SummarizeUnlockRisk(unlocks[3:], today))
+	assert.Equal(t, "✅ 未来30日无限售解禁", SummarizeUnlockRisk(nil, today))
+}
@@ -0,0 +1,71 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+)
+
+// valuationYears is the look-back window the prediction compares the valuation with
+const valuationYears = 5
+
+// GetValuationSummary fetches the valuation bands of a stock and condenses them into one line
+func GetValuationSummary(ctx context.Context, client stockservice.Client, code string) (string, error) {
+	resp, err := client.GetValuation(ctx, &stock.GetValuationRequest{Code: code, Years: valuationYears})
+	if err != nil {
+		return "", err
+	}
+	return SummarizeValuation(resp), nil
+}
+
+// SummarizeValuation places PE-TTM, PB and PS-TTM within the look-back window and against the
+// industry median on one line, e.g. "PE-TTM 25.3 at the 12th percentile of 5 years (...)"
+func SummarizeValuation(resp *stock.GetValuationResponse) string {
+	if len(resp.Days) == 0 {
+		return "Valuation: No data"
+	}
+
+	parts := []string{
+		describeBand("PE-TTM", resp.PeTtm, resp.Years),
+		describeBand("PB", resp.Pb, resp.Years),
+		describeBand("PS-TTM", resp.PsTtm, resp.Years),
+	}
+	line := strings.Join(parts, "; ")
+	if resp.Industry != "" {
+		line += fmt.Sprintf(" (Industry: %s)", resp.Industry)
+	}
+	return line
+}
+
+func describeBand(label string, b *stock.ValuationBand, years int32) string {
+	if b == nil || b.Current <= 0 {
+		return label + " n/a (negative, loss or negative equity)"
+	}
+	s := fmt.Sprintf("%s %.2f at the %s percentile of %d years (range %.2f-%.2f, median %.2f",
+		label, b.Current, ordinal(int(math.Round(b.Percentile))), years, b.Min, b.Max, b.Median)
+	if b.IndustryMedian > 0 {
+		s += fmt.Sprintf(", industry median %.2f", b.IndustryMedian)
+	}
+	return s + ")"
+}
+
+// ordinal formats n as 1st, 2nd, 3rd, 4th, 11th, 12th, 21st...
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,39 @@
+package tool
+
+import (
+	"testing"
+
+	"stock_assistant/backend/ai_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeValuation(t *testing.T) {
+	resp := &stock.GetValuationResponse{
+		Code:     "sh600519",
+		Industry: "酿酒行业",
+		Years:    5,
+		Days:     []*stock.ValuationDay{{Date: "2024-05-15", PeTtm: 25.3}},
+		PeTtm:    &stock.ValuationBand{Current: 25.3, Percentile: 11.8, Min: 20, Median: 32, Max: 55, IndustryMedian: 28.4},
+		Pb:       &stock.ValuationBand{Current: 8.1, Percentile: 22.2, Min: 6, Median: 10, Max: 16},
+		PsTtm:    &stock.ValuationBand{},
+	}
+
+	summary := SummarizeValuation(resp)
+	assert.NotContains(t, summary, "\n")
+	assert.Contains(t, summary, "PE-TTM 25.30 at the 12th percentile of 5 years (range 20.00-55.00, median 32.00, industry median 28.40)")
+	assert.Contains(t, summary, "PB 8.10 at the 22nd percentile of 5 years (range 6.00-16.00, median 10.00)")
+	assert.Contains(t, summary, "PS-TTM n/a")
+	assert.Contains(t, summary, "(Industry: 酿酒行业)")
+
+	assert.Equal(t, "Valuation: No data", SummarizeValuation(&stock.GetValuationResponse{}))
+}
+
+func TestOrdinal(t *testing.T) {
+	assert.Equal(t, "1st", ordinal(1))
+	assert.Equal(t, "3rd", ordinal(3))
+	assert.Equal(t, "11th", ordinal(11))
+	assert.Equal(t, "13th", ordinal(13))
+	assert.Equal(t, "21st", ordinal(21))
+	assert.Equal(t, "100th", ordinal(100))
+}
@@ -8,6 +8,7 @@ require (
 	github.com/cloudwego/kitex/pkg/protocol/bthrift v0.0.0-20251226083253-6e03ff303082
 	github.com/stretchr/testify v1.10.0
 	github.com/tmc/langchaingo v0.1.14
+	stock_assistant/backend/common v0.0.0
 )
 
 require (
@@ -74,3 +75,5 @@ require (
 )
 
 replace github.com/apache/thrift => github.com/apache/thrift v0.13.0
+
+replace stock_assistant/backend/common => ../common
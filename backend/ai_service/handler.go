@@ -9,6 +9,7 @@ import (
 	ai "stock_assistant/backend/ai_service/kitex_gen/ai"
 	"stock_assistant/backend/ai_service/kitex_gen/stock"
 	"stock_assistant/backend/ai_service/kitex_gen/stock/stockservice"
+	"stock_assistant/backend/common/calendar"
 
 	"github.com/cloudwego/kitex/client"
 )
@@ -104,6 +105,7 @@ func (s *AIServiceImpl) ImageRecognition(ctx context.Context, req *ai.ImageRecog
 // MarketReview implements the AIServiceImpl interface.
 func (s *AIServiceImpl) MarketReview(ctx context.Context, req *ai.MarketReviewRequest) (resp *ai.MarketReviewResponse, err error) {
 	log.Printf("Received market review request: Date=%s", req.Date)
+	date := calendar.DefaultDate(req.Date)
 
 	// 1. Fetch Sector Data
 	sectorReq := &stock.GetMarketSectorsRequest{
@@ -118,7 +120,7 @@ func (s *AIServiceImpl) MarketReview(ctx context.Context, req *ai.MarketReviewRe
 
 	// 2. Fetch Limit Up Data
 	limitUpReq := &stock.GetLimitUpPoolRequest{
-		Date: req.Date,
+		Date: date,
 	}
 	limitUpResp, err := s.stockClient.GetLimitUpPool(ctx, limitUpReq)
 	if err != nil {
@@ -126,9 +128,16 @@ func (s *AIServiceImpl) MarketReview(ctx context.Context, req *ai.MarketReviewRe
 		return nil, err
 	}
 
+	// Broken-board and limit-down counts, the review still works without them
+	sentimentResp, err := s.stockClient.GetSentimentSnapshot(ctx, &stock.GetSentimentSnapshotRequest{Date: date})
+	if err != nil {
+		log.Printf("Failed to get sentiment snapshot: %v", err)
+		sentimentResp = &stock.GetSentimentSnapshotResponse{}
+	}
+
 	// 3. Fetch Dragon Tiger List
 	dtReq := &stock.GetDragonTigerListRequest{
-		Date: req.Date,
+		Date: date,
 	}
 	dtResp, err := s.stockClient.GetDragonTigerList(ctx, dtReq)
 	if err != nil {
@@ -137,8 +146,37 @@ func (s *AIServiceImpl) MarketReview(ctx context.Context, req *ai.MarketReviewRe
 		dtResp = &stock.GetDragonTigerListResponse{}
 	}
 
-	// 4. Call LLM Provider
-	review, err := s.llmProvider.ReviewMarket(ctx, sectorResp.Sectors, limitUpResp.Stocks, dtResp.Items, req.Date)
+	// 4. Fetch Index Overview (default index set), the closes of date for a past session
+	indexResp, err := s.stockClient.GetIndexOverview(ctx, &stock.GetIndexOverviewRequest{Date: date})
+	if err != nil {
+		log.Printf("Failed to get index overview: %v", err)
+		indexResp = &stock.GetIndexOverviewResponse{}
+	}
+
+	// Up/down counts with the last five sessions to compare against
+	breadthResp, err := s.stockClient.GetMarketBreadth(ctx, &stock.GetMarketBreadthRequest{
+		Date:        date,
+		HistoryDays: 5,
+	})
+	if err != nil {
+		log.Printf("Failed to get market breadth: %v", err)
+		breadthResp = &stock.GetMarketBreadthResponse{}
+	}
+
+	// Northbound flow and the connects' top traded stocks as macro context
+	northboundResp, err := s.stockClient.GetNorthboundFlow(ctx, &stock.GetNorthboundFlowRequest{Days: 5, Date: date})
+	if err != nil {
+		log.Printf("Failed to get northbound flow: %v", err)
+		northboundResp = &stock.GetNorthboundFlowResponse{}
+	}
+	northboundTopResp, err := s.stockClient.GetNorthboundTopStocks(ctx, &stock.GetNorthboundTopStocksRequest{Date: date})
+	if err != nil {
+		log.Printf("Failed to get northbound top stocks: %v", err)
+		northboundTopResp = &stock.GetNorthboundTopStocksResponse{}
+	}
+
+	// 5. Call LLM Provider
+	review, err := s.llmProvider.ReviewMarket(ctx, indexResp.Indices, breadthResp, northboundResp, northboundTopResp, sectorResp.Sectors, limitUpResp.Stocks, sentimentResp.Snapshot, dtResp.Items, date)
 	if err != nil {
 		log.Printf("Failed to generate market review: %v", err)
 		return nil, err
@@ -150,6 +188,7 @@ func (s *AIServiceImpl) MarketReview(ctx context.Context, req *ai.MarketReviewRe
 // AnalyzeMarket implements the AIServiceImpl interface.
 func (s *AIServiceImpl) AnalyzeMarket(ctx context.Context, req *ai.MarketAnalysisRequest) (resp *ai.MarketAnalysisResponse, err error) {
 	log.Printf("Received market analysis request: Date=%s", req.Date)
+	date := calendar.DefaultDate(req.Date)
 
 	// 1. Fetch Sector Data
 	sectorReq := &stock.GetMarketSectorsRequest{
@@ -162,9 +201,21 @@ func (s *AIServiceImpl) AnalyzeMarket(ctx context.Context, req *ai.MarketAnalysi
 		return nil, err
 	}
 
+	// Multi-day rotation, so the model can tell a new main line from a fading one
+	rotationResp, err := s.stockClient.GetSectorRotation(ctx, &stock.GetSectorRotationRequest{
+		Days:  5,
+		Type:  "concept",
+		Limit: 10,
+		Date:  date,
+	})
+	if err != nil {
+		log.Printf("Failed to get sector rotation: %v", err)
+		rotationResp = &stock.GetSectorRotationResponse{}
+	}
+
 	// 2. Fetch Limit Up Data
 	limitUpReq := &stock.GetLimitUpPoolRequest{
-		Date: req.Date,
+		Date: date,
 	}
 	limitUpResp, err := s.stockClient.GetLimitUpPool(ctx, limitUpReq)
 	if err != nil {
@@ -172,9 +223,16 @@ func (s *AIServiceImpl) AnalyzeMarket(ctx context.Context, req *ai.MarketAnalysi
 		return nil, err
 	}
 
+	// Broken-board and limit-down counts, the review still works without them
+	sentimentResp, err := s.stockClient.GetSentimentSnapshot(ctx, &stock.GetSentimentSnapshotRequest{Date: date})
+	if err != nil {
+		log.Printf("Failed to get sentiment snapshot: %v", err)
+		sentimentResp = &stock.GetSentimentSnapshotResponse{}
+	}
+
 	// 3. Fetch Dragon Tiger List
 	dtReq := &stock.GetDragonTigerListRequest{
-		Date: req.Date,
+		Date: date,
 	}
 	dtResp, err := s.stockClient.GetDragonTigerList(ctx, dtReq)
 	if err != nil {
@@ -183,9 +241,16 @@ func (s *AIServiceImpl) AnalyzeMarket(ctx context.Context, req *ai.MarketAnalysi
 		dtResp = &stock.GetDragonTigerListResponse{}
 	}
 
-	// 4. Call LLM Provider
-	log.Printf("Calling AnalyzeMarket with: Sectors=%d, LimitUps=%d, DTItems=%d", len(sectorResp.Sectors), len(limitUpResp.Stocks), len(dtResp.Items))
-	analysis, err := s.llmProvider.AnalyzeMarket(ctx, sectorResp.Sectors, limitUpResp.Stocks, dtResp.Items, req.Date)
+	// 4. Fetch Index Overview (default index set), the closes of date for a past session
+	indexResp, err := s.stockClient.GetIndexOverview(ctx, &stock.GetIndexOverviewRequest{Date: date})
+	if err != nil {
+		log.Printf("Failed to get index overview: %v", err)
+		indexResp = &stock.GetIndexOverviewResponse{}
+	}
+
+	// 5. Call LLM Provider
+	log.Printf("Calling AnalyzeMarket with: Indices=%d, Sectors=%d, LimitUps=%d, DTItems=%d", len(indexResp.Indices), len(sectorResp.Sectors), len(limitUpResp.Stocks), len(dtResp.Items))
+	analysis, err := s.llmProvider.AnalyzeMarket(ctx, indexResp.Indices, sectorResp.Sectors, rotationResp.Sectors, limitUpResp.Stocks, sentimentResp.Snapshot, dtResp.Items, date)
 	if err != nil {
 		log.Printf("Failed to generate market analysis: %v", err)
 		return nil, err
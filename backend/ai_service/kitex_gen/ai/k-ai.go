@@ -1,4 +1,4 @@
-// Code generated by Kitex v1.20.0. DO NOT EDIT.
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
 
 package ai
 
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/cloudwego/gopkg/protocol/thrift"
-	kutils "github.com/cloudwego/kitex/pkg/utils"
 )
 
 // unused protection
@@ -258,19 +257,13 @@ func (p *PredictionResult_) DeepCopy(s interface{}) error {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Code != "" {
-		p.Code = kutils.StringDeepCopy(src.Code)
-	}
+	p.Code = src.Code
 
 	p.Confidence = src.Confidence
 
-	if src.Analysis != "" {
-		p.Analysis = kutils.StringDeepCopy(src.Analysis)
-	}
+	p.Analysis = src.Analysis
 
-	if src.NewsSummary_ != "" {
-		p.NewsSummary_ = kutils.StringDeepCopy(src.NewsSummary_)
-	}
+	p.NewsSummary_ = src.NewsSummary_
 
 	return nil
 }
@@ -512,17 +505,13 @@ func (p *GetPredictionRequest) DeepCopy(s interface{}) error {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Code != "" {
-		p.Code = kutils.StringDeepCopy(src.Code)
-	}
+	p.Code = src.Code
 
 	p.Days = src.Days
 
 	p.IncludeNews = src.IncludeNews
 
-	if src.Model != "" {
-		p.Model = kutils.StringDeepCopy(src.Model)
-	}
+	p.Model = src.Model
 
 	return nil
 }
@@ -798,9 +787,7 @@ func (p *ImageRecognitionRequest) DeepCopy(s interface{}) error {
 		p.ImageData = tmp
 	}
 
-	if src.Model != "" {
-		p.Model = kutils.StringDeepCopy(src.Model)
-	}
+	p.Model = src.Model
 
 	return nil
 }
@@ -954,13 +941,9 @@ func (p *RecognizedStock) DeepCopy(s interface{}) error {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Code != "" {
-		p.Code = kutils.StringDeepCopy(src.Code)
-	}
+	p.Code = src.Code
 
-	if src.Name != "" {
-		p.Name = kutils.StringDeepCopy(src.Name)
-	}
+	p.Name = src.Name
 
 	return nil
 }
@@ -1280,17 +1263,13 @@ func (p *MarketReviewRequest) DeepCopy(s interface{}) error {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Date != "" {
-		p.Date = kutils.StringDeepCopy(src.Date)
-	}
+	p.Date = src.Date
 
 	if src.FocusSectors != nil {
 		p.FocusSectors = make([]string, 0, len(src.FocusSectors))
 		for _, elem := range src.FocusSectors {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.FocusSectors = append(p.FocusSectors, _elem)
 		}
 	}
@@ -1621,25 +1600,17 @@ func (p *MarketReviewResponse) DeepCopy(s interface{}) error {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Summary != "" {
-		p.Summary = kutils.StringDeepCopy(src.Summary)
-	}
+	p.Summary = src.Summary
 
-	if src.SectorAnalysis != "" {
-		p.SectorAnalysis = kutils.StringDeepCopy(src.SectorAnalysis)
-	}
+	p.SectorAnalysis = src.SectorAnalysis
 
-	if src.SentimentAnalysis != "" {
-		p.SentimentAnalysis = kutils.StringDeepCopy(src.SentimentAnalysis)
-	}
+	p.SentimentAnalysis = src.SentimentAnalysis
 
 	if src.KeyRisks != nil {
 		p.KeyRisks = make([]string, 0, len(src.KeyRisks))
 		for _, elem := range src.KeyRisks {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.KeyRisks = append(p.KeyRisks, _elem)
 		}
 	}
@@ -1648,9 +1619,7 @@ func (p *MarketReviewResponse) DeepCopy(s interface{}) error {
 		p.Opportunities = make([]string, 0, len(src.Opportunities))
 		for _, elem := range src.Opportunities {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.Opportunities = append(p.Opportunities, _elem)
 		}
 	}
@@ -1763,9 +1732,7 @@ func (p *MarketAnalysisRequest) DeepCopy(s interface{}) error {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Date != "" {
-		p.Date = kutils.StringDeepCopy(src.Date)
-	}
+	p.Date = src.Date
 
 	return nil
 }
@@ -2139,9 +2106,7 @@ func (p *MarketAnalysisResponse) DeepCopy(s interface{}) error {
 		p.HotStocks = make([]string, 0, len(src.HotStocks))
 		for _, elem := range src.HotStocks {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.HotStocks = append(p.HotStocks, _elem)
 		}
 	}
@@ -2150,9 +2115,7 @@ func (p *MarketAnalysisResponse) DeepCopy(s interface{}) error {
 		p.RecommendedStocks = make([]string, 0, len(src.RecommendedStocks))
 		for _, elem := range src.RecommendedStocks {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.RecommendedStocks = append(p.RecommendedStocks, _elem)
 		}
 	}
@@ -2161,9 +2124,7 @@ func (p *MarketAnalysisResponse) DeepCopy(s interface{}) error {
 		p.Risks = make([]string, 0, len(src.Risks))
 		for _, elem := range src.Risks {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.Risks = append(p.Risks, _elem)
 		}
 	}
@@ -2172,16 +2133,12 @@ func (p *MarketAnalysisResponse) DeepCopy(s interface{}) error {
 		p.Opportunities = make([]string, 0, len(src.Opportunities))
 		for _, elem := range src.Opportunities {
 			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
+			_elem = elem
 			p.Opportunities = append(p.Opportunities, _elem)
 		}
 	}
 
-	if src.AnalysisSummary != "" {
-		p.AnalysisSummary = kutils.StringDeepCopy(src.AnalysisSummary)
-	}
+	p.AnalysisSummary = src.AnalysisSummary
 
 	return nil
 }
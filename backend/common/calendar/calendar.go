@@ -0,0 +1,208 @@
+// Package calendar is the A-share trading calendar and market clock.
+//
+// All dates and session times are exchange time (Asia/Shanghai), whatever the
+// time zone of the host. Holidays come from the bundled holidays.txt.
+package calendar
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// Bundle the zone database, containers often ship without /usr/share/zoneinfo
+	_ "time/tzdata"
+)
+
+// DateLayout is the date format used across the services
+const DateLayout = "2006-01-02"
+
+// Location is the exchange time zone
+var Location = mustLoadLocation("Asia/Shanghai")
+
+//go:embed holidays.txt
+var holidayData string
+
+var defaultCalendar = mustParse(holidayData)
+
+// Calendar knows which weekdays the exchanges are closed
+type Calendar struct {
+	holidays map[string]bool
+	years    map[int]bool
+}
+
+// Default returns the calendar built from the bundled holiday file
+func Default() *Calendar {
+	return defaultCalendar
+}
+
+// Parse reads a holiday file: one YYYY-MM-DD per line, "#" starts a comment,
+// and a "years" line lists the years the file fully covers.
+func Parse(data string) (*Calendar, error) {
+	c := &Calendar{holidays: make(map[string]bool), years: make(map[int]bool)}
+
+	sc := bufio.NewScanner(strings.NewReader(data))
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if fields[0] == "years" {
+			for _, f := range fields[1:] {
+				y, err := strconv.Atoi(f)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid year %q", n, f)
+				}
+				c.years[y] = true
+			}
+			continue
+		}
+
+		d, err := time.Parse(DateLayout, fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		c.holidays[d.Format(DateLayout)] = true
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Covers reports whether the holidays of year are known. Outside the covered
+// years every weekday is taken as a trading day.
+func (c *Calendar) Covers(year int) bool {
+	return c.years[year]
+}
+
+// IsTradingDay reports whether the exchange date of t is a trading day
+func (c *Calendar) IsTradingDay(t time.Time) bool {
+	t = t.In(Location)
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !c.holidays[t.Format(DateLayout)]
+}
+
+// PrevTradingDay returns the last trading day strictly before the date of t,
+// at midnight exchange time
+func (c *Calendar) PrevTradingDay(t time.Time) time.Time {
+	d := startOfDay(t)
+	for {
+		d = d.AddDate(0, 0, -1)
+		if c.IsTradingDay(d) {
+			return d
+		}
+	}
+}
+
+// NextTradingDay returns the first trading day strictly after the date of t,
+// at midnight exchange time
+func (c *Calendar) NextTradingDay(t time.Time) time.Time {
+	d := startOfDay(t)
+	for {
+		d = d.AddDate(0, 0, 1)
+		if c.IsTradingDay(d) {
+			return d
+		}
+	}
+}
+
+// LatestCompletedTradingDay returns the most recent trading day whose session has
+// closed at t: the date of t itself from 15:00 on, otherwise the trading day before.
+func (c *Calendar) LatestCompletedTradingDay(t time.Time) time.Time {
+	t = t.In(Location)
+	if c.IsTradingDay(t) && minuteOfDay(t) >= closeMinute {
+		return startOfDay(t)
+	}
+	return c.PrevTradingDay(t)
+}
+
+// LatestTradingDay returns the most recent trading day whose session has opened at t:
+// the date of t itself from 09:30 on, otherwise the trading day before. Unlike
+// LatestCompletedTradingDay it includes the running session.
+func (c *Calendar) LatestTradingDay(t time.Time) time.Time {
+	t = t.In(Location)
+	if c.IsTradingDay(t) && minuteOfDay(t) >= openMinute {
+		return startOfDay(t)
+	}
+	return c.PrevTradingDay(t)
+}
+
+// TradingDaysBetween counts the trading days in [from, to], both dates included
+func (c *Calendar) TradingDaysBetween(from, to time.Time) int {
+	n := 0
+	for d, end := startOfDay(from), startOfDay(to); !d.After(end); d = d.AddDate(0, 0, 1) {
+		if c.IsTradingDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// Now returns the current time in exchange time
+func Now() time.Time {
+	return time.Now().In(Location)
+}
+
+// IsTradingDay reports whether the date of t is a trading day in the default calendar
+func IsTradingDay(t time.Time) bool {
+	return defaultCalendar.IsTradingDay(t)
+}
+
+// PrevTradingDay is Default().PrevTradingDay
+func PrevTradingDay(t time.Time) time.Time {
+	return defaultCalendar.PrevTradingDay(t)
+}
+
+// NextTradingDay is Default().NextTradingDay
+func NextTradingDay(t time.Time) time.Time {
+	return defaultCalendar.NextTradingDay(t)
+}
+
+// LatestCompletedTradingDay is Default().LatestCompletedTradingDay
+func LatestCompletedTradingDay(t time.Time) time.Time {
+	return defaultCalendar.LatestCompletedTradingDay(t)
+}
+
+// LatestTradingDay is Default().LatestTradingDay
+func LatestTradingDay(t time.Time) time.Time {
+	return defaultCalendar.LatestTradingDay(t)
+}
+
+// DefaultDate returns date unchanged when set, otherwise the latest completed
+// trading day as YYYY-MM-DD. It is the common default of the "date" parameters.
+func DefaultDate(date string) string {
+	if date != "" {
+		return date
+	}
+	return LatestCompletedTradingDay(Now()).Format(DateLayout)
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.In(Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, Location)
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+func mustParse(data string) *Calendar {
+	c, err := Parse(data)
+	if err != nil {
+		panic(fmt.Sprintf("calendar: invalid bundled holidays.txt: %v", err))
+	}
+	return c
+}
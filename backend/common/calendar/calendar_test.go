@@ -0,0 +1,103 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func day(s string) time.Time {
+	t, err := time.ParseInLocation(DateLayout, s, Location)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func at(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, Location)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestIsTradingDay(t *testing.T) {
+	assert.True(t, IsTradingDay(day("2025-09-30")))
+	assert.False(t, IsTradingDay(day("2025-10-01")), "National Day")
+	assert.False(t, IsTradingDay(day("2025-10-08")), "Mid-Autumn extension")
+	assert.True(t, IsTradingDay(day("2025-10-09")))
+	assert.False(t, IsTradingDay(day("2025-10-11")), "make-up workdays stay closed on weekends")
+	assert.False(t, IsTradingDay(day("2026-02-16")), "Spring Festival")
+	assert.True(t, Default().Covers(2026))
+	assert.False(t, Default().Covers(2030))
+	assert.True(t, IsTradingDay(day("2030-01-02")), "uncovered years fall back to weekdays")
+
+	// The exchange date counts, not the host's: 17:00 UTC on Sep 30 is already Oct 1 in Shanghai
+	assert.False(t, IsTradingDay(time.Date(2025, 9, 30, 17, 0, 0, 0, time.UTC)))
+}
+
+func TestPrevNextTradingDay(t *testing.T) {
+	assert.Equal(t, day("2025-09-30"), PrevTradingDay(day("2025-10-09")))
+	assert.Equal(t, day("2025-10-09"), NextTradingDay(day("2025-09-30")))
+	assert.Equal(t, day("2026-02-13"), PrevTradingDay(at("2026-02-24 10:00")))
+	assert.Equal(t, day("2024-12-31"), PrevTradingDay(day("2025-01-02")))
+	assert.Equal(t, 3, Default().TradingDaysBetween(day("2025-09-29"), day("2025-10-09")))
+}
+
+func TestLatestCompletedTradingDay(t *testing.T) {
+	// Before the close the session is not complete yet
+	assert.Equal(t, day("2025-09-29"), LatestCompletedTradingDay(at("2025-09-30 14:59")))
+	assert.Equal(t, day("2025-09-30"), LatestCompletedTradingDay(at("2025-09-30 15:00")))
+	// Weekends and holidays resolve to the last session before them
+	assert.Equal(t, day("2025-09-30"), LatestCompletedTradingDay(at("2025-10-05 12:00")))
+	assert.Equal(t, day("2025-05-30"), LatestCompletedTradingDay(at("2025-06-02 20:00")))
+
+	// The running session counts from the open
+	assert.Equal(t, day("2025-09-29"), LatestTradingDay(at("2025-09-30 09:29")))
+	assert.Equal(t, day("2025-09-30"), LatestTradingDay(at("2025-09-30 09:30")))
+	assert.Equal(t, day("2025-09-30"), LatestTradingDay(at("2025-10-05 12:00")))
+
+	assert.Equal(t, "2024-01-05", DefaultDate("2024-01-05"))
+	assert.Len(t, DefaultDate(""), len(DateLayout))
+}
+
+func TestPhase(t *testing.T) {
+	cal := Default()
+	cases := map[string]Phase{
+		"2025-09-30 09:14": PhaseClosed,
+		"2025-09-30 09:15": PhasePreOpen,
+		"2025-09-30 09:29": PhasePreOpen,
+		"2025-09-30 09:30": PhaseMorning,
+		"2025-09-30 11:29": PhaseMorning,
+		"2025-09-30 11:30": PhaseLunch,
+		"2025-09-30 13:00": PhaseAfternoon,
+		"2025-09-30 14:57": PhaseClosingAuction,
+		"2025-09-30 15:00": PhaseClosed,
+		"2025-10-01 10:00": PhaseClosed,
+		"2025-10-04 10:00": PhaseClosed,
+	}
+	for s, want := range cases {
+		assert.Equal(t, want, cal.Phase(at(s)), s)
+	}
+
+	assert.True(t, IsOpen(at("2025-09-30 10:00")))
+	assert.False(t, IsOpen(at("2025-09-30 12:00")))
+	// 02:00 UTC is 10:00 in Shanghai
+	assert.Equal(t, PhaseMorning, cal.Phase(time.Date(2025, 9, 30, 2, 0, 0, 0, time.UTC)))
+	assert.Equal(t, at("2025-09-30 15:00"), CloseTime(day("2025-09-30")))
+	assert.Equal(t, at("2025-09-30 15:00"), CloseTime(time.Date(2025, 9, 29, 20, 0, 0, 0, time.UTC)))
+}
+
+func TestParse(t *testing.T) {
+	cal, err := Parse("years 2024\n2024-01-01 # New Year\n\n")
+	assert.Nil(t, err)
+	assert.False(t, cal.IsTradingDay(day("2024-01-01")))
+	assert.True(t, cal.Covers(2024))
+
+	_, err = Parse("2024-13-01")
+	assert.NotNil(t, err)
+	_, err = Parse("years twenty")
+	assert.NotNil(t, err)
+}
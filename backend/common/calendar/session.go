@@ -0,0 +1,78 @@
+package calendar
+
+import "time"
+
+// Phase is the session state of the market at a given moment
+type Phase string
+
+const (
+	PhaseClosed         Phase = "closed"          // Non-trading day, or outside the sessions below
+	PhasePreOpen        Phase = "pre_open"        // 09:15-09:30 opening call auction
+	PhaseMorning        Phase = "morning"         // 09:30-11:30 continuous trading
+	PhaseLunch          Phase = "lunch"           // 11:30-13:00 break
+	PhaseAfternoon      Phase = "afternoon"       // 13:00-14:57 continuous trading
+	PhaseClosingAuction Phase = "closing_auction" // 14:57-15:00 closing call auction
+)
+
+// Session boundaries in minutes from midnight, each phase includes its start and excludes its end
+const (
+	preOpenMinute        = 9*60 + 15
+	openMinute           = 9*60 + 30
+	lunchMinute          = 11*60 + 30
+	afternoonMinute      = 13 * 60
+	closingAuctionMinute = 14*60 + 57
+	closeMinute          = 15 * 60
+)
+
+// Phase returns the session phase at t
+func (c *Calendar) Phase(t time.Time) Phase {
+	t = t.In(Location)
+	if !c.IsTradingDay(t) {
+		return PhaseClosed
+	}
+
+	switch m := minuteOfDay(t); {
+	case m < preOpenMinute:
+		return PhaseClosed
+	case m < openMinute:
+		return PhasePreOpen
+	case m < lunchMinute:
+		return PhaseMorning
+	case m < afternoonMinute:
+		return PhaseLunch
+	case m < closingAuctionMinute:
+		return PhaseAfternoon
+	case m < closeMinute:
+		return PhaseClosingAuction
+	}
+	return PhaseClosed
+}
+
+// IsOpen reports whether orders are being matched or collected at t,
+// i.e. any phase but closed and the lunch break
+func (c *Calendar) IsOpen(t time.Time) bool {
+	switch c.Phase(t) {
+	case PhaseClosed, PhaseLunch:
+		return false
+	}
+	return true
+}
+
+// CloseTime returns the close of the session on the date of t, in Location
+func CloseTime(t time.Time) time.Time {
+	return startOfDay(t).Add(closeMinute * time.Minute)
+}
+
+// CurrentPhase returns the session phase right now in the default calendar
+func CurrentPhase() Phase {
+	return defaultCalendar.Phase(Now())
+}
+
+// IsOpen reports whether the market is open at t in the default calendar
+func IsOpen(t time.Time) bool {
+	return defaultCalendar.IsOpen(t)
+}
+
+func minuteOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
@@ -0,0 +1,285 @@
+// Package symbol parses A-share security codes in the formats used by the
+// different data vendors and classifies them by exchange and board.
+//
+// Accepted inputs (case-insensitive, surrounding spaces ignored):
+//
+//	600519       bare six-digit code, exchange guessed from the prefix
+//	sh600519     Sina / Tencent style exchange prefix
+//	1.600519     EastMoney secid
+//	600519.SH    suffix style (.SS is accepted for Shanghai as well)
+package symbol
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Exchange is the listing venue
+type Exchange string
+
+const (
+	SSE  Exchange = "SH" // Shanghai Stock Exchange
+	SZSE Exchange = "SZ" // Shenzhen Stock Exchange
+	BSE  Exchange = "BJ" // Beijing Stock Exchange
+)
+
+// Board is the market segment a security trades on, it decides the price limit band
+type Board string
+
+const (
+	BoardMain    Board = "main"    // 沪深主板 (including B shares)
+	BoardSTAR    Board = "star"    // 科创板
+	BoardChiNext Board = "chinext" // 创业板
+	BoardBSE     Board = "bse"     // 北交所
+	BoardETF     Board = "etf"     // Exchange traded funds and LOFs
+	BoardIndex   Board = "index"
+)
+
+// Type is the kind of security, the values match the security master ("stock", "etf", "index")
+type Type string
+
+const (
+	TypeStock Type = "stock"
+	TypeETF   Type = "etf"
+	TypeIndex Type = "index"
+)
+
+// Security is the canonical form of a parsed code
+type Security struct {
+	Symbol   string // Six digits, e.g. 600519
+	Exchange Exchange
+	Board    Board
+	Type     Type
+}
+
+// Parse resolves a code in any of the accepted formats. Bare codes are read as
+// stocks or funds, so "000001" is 平安银行; use ParseIndex for index codes.
+func Parse(input string) (Security, error) {
+	return parse(input, false)
+}
+
+// ParseIndex is like Parse but reads bare codes as indices first, so "000001"
+// is 上证指数 and "399006" is 创业板指. Codes carrying an exchange are
+// classified the same way as in Parse.
+func ParseIndex(input string) (Security, error) {
+	return parse(input, true)
+}
+
+// Prefixed renders the Sina / Tencent form, e.g. sh600519
+func (s Security) Prefixed() string {
+	return strings.ToLower(string(s.Exchange)) + s.Symbol
+}
+
+// SecID renders the EastMoney secid, e.g. 1.600519 (Shenzhen and Beijing share market 0)
+func (s Security) SecID() string {
+	if s.Exchange == SSE {
+		return "1." + s.Symbol
+	}
+	return "0." + s.Symbol
+}
+
+// Suffixed renders the exchange suffix form, e.g. 600519.SH
+func (s Security) Suffixed() string {
+	return s.Symbol + "." + string(s.Exchange)
+}
+
+// String returns the prefixed form, which is the code format used across the services
+func (s Security) String() string {
+	return s.Prefixed()
+}
+
+// stPrefixes are the risk warning markers put in front of a name
+var stPrefixes = []string{"S*ST", "SST", "*ST", "ST"}
+
+// IsST reports whether a security name carries a risk warning (ST, *ST, S*ST, SST)
+func IsST(name string) bool {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	for _, prefix := range stPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// PriceLimit returns the daily price limit band in percent, 0 when the security
+// has none (indices). name is only used to detect ST stocks and may be empty.
+// The first days after listing are not covered, they have no limit either.
+func (s Security) PriceLimit(name string) float64 {
+	switch s.Board {
+	case BoardIndex:
+		return 0
+	case BoardBSE:
+		return 30
+	case BoardSTAR, BoardChiNext:
+		// ST stocks on these boards keep the 20% band
+		return 20
+	case BoardMain:
+		if IsST(name) {
+			return 5
+		}
+	}
+	return 10
+}
+
+// LimitPrices returns the limit-up and limit-down prices for a previous close,
+// rounded to the 0.01 tick the way the exchanges do (half away from zero).
+// Both are 0 when the security has no price limit.
+func (s Security) LimitPrices(prevClose float64, name string) (up, down float64) {
+	pct := s.PriceLimit(name)
+	if pct == 0 || prevClose <= 0 {
+		return 0, 0
+	}
+	up = roundTick(prevClose * (1 + pct/100))
+	down = roundTick(prevClose * (1 - pct/100))
+	return up, down
+}
+
+func roundTick(v float64) float64 {
+	// The epsilon absorbs float noise such as 11.55 * 1.1 = 12.704999...
+	return math.Floor(v*100+0.5+1e-6) / 100
+}
+
+func parse(input string, preferIndex bool) (Security, error) {
+	code := strings.ToUpper(strings.TrimSpace(input))
+	var exchange Exchange
+	var secIDMarket string
+
+	switch {
+	case len(code) == 8 && (strings.HasPrefix(code, "SH") || strings.HasPrefix(code, "SZ") || strings.HasPrefix(code, "BJ")):
+		exchange, code = Exchange(code[:2]), code[2:]
+	case len(code) == 8 && code[1] == '.':
+		secIDMarket, code = code[:1], code[2:]
+	case len(code) == 9 && code[6] == '.':
+		switch code[7:] {
+		case "SH", "SS":
+			exchange = SSE
+		case "SZ":
+			exchange = SZSE
+		case "BJ":
+			exchange = BSE
+		default:
+			return Security{}, fmt.Errorf("unsupported exchange suffix in code: %s", input)
+		}
+		code = code[:6]
+	}
+
+	if !isSixDigits(code) {
+		return Security{}, fmt.Errorf("invalid security code: %s", input)
+	}
+
+	switch secIDMarket {
+	case "":
+	case "1":
+		exchange = SSE
+	case "0":
+		// EastMoney lists Beijing under market 0 as well
+		exchange = SZSE
+		if guessExchange(code, false) == BSE {
+			exchange = BSE
+		}
+	default:
+		return Security{}, fmt.Errorf("unsupported secid market in code: %s", input)
+	}
+
+	if exchange == "" {
+		exchange = guessExchange(code, preferIndex)
+		if exchange == "" {
+			return Security{}, fmt.Errorf("unknown exchange for code: %s", input)
+		}
+	}
+
+	board := classify(exchange, code)
+	return Security{
+		Symbol:   code,
+		Exchange: exchange,
+		Board:    board,
+		Type:     typeOf(board),
+	}, nil
+}
+
+// guessExchange infers the exchange of a bare code from its prefix
+func guessExchange(code string, preferIndex bool) Exchange {
+	if preferIndex {
+		switch {
+		case hasAnyPrefix(code, "000", "93"):
+			return SSE
+		case strings.HasPrefix(code, "399"):
+			return SZSE
+		case strings.HasPrefix(code, "899"):
+			return BSE
+		}
+	}
+
+	switch {
+	case hasAnyPrefix(code, "920", "43", "83", "87", "88", "899"):
+		return BSE
+	case hasAnyPrefix(code, "60", "68", "900", "50", "51", "52", "53", "56", "58"):
+		return SSE
+	case hasAnyPrefix(code, "00", "20", "30", "15", "16", "18", "399"):
+		return SZSE
+	}
+	return ""
+}
+
+// classify decides the board of a code whose exchange is known
+func classify(exchange Exchange, code string) Board {
+	switch exchange {
+	case SSE:
+		switch {
+		case hasAnyPrefix(code, "000", "880", "93", "95"):
+			return BoardIndex
+		case hasAnyPrefix(code, "688", "689"):
+			return BoardSTAR
+		case strings.HasPrefix(code, "5"):
+			return BoardETF
+		}
+	case SZSE:
+		switch {
+		case strings.HasPrefix(code, "399"):
+			return BoardIndex
+		case hasAnyPrefix(code, "30"):
+			return BoardChiNext
+		case hasAnyPrefix(code, "15", "16", "18"):
+			return BoardETF
+		}
+	case BSE:
+		if strings.HasPrefix(code, "899") {
+			return BoardIndex
+		}
+		return BoardBSE
+	}
+	return BoardMain
+}
+
+func typeOf(board Board) Type {
+	switch board {
+	case BoardIndex:
+		return TypeIndex
+	case BoardETF:
+		return TypeETF
+	}
+	return TypeStock
+}
+
+func isSixDigits(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func hasAnyPrefix(s string, prefixes ...string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package symbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFormats(t *testing.T) {
+	for _, input := range []string{"600519", " sh600519 ", "SH600519", "1.600519", "600519.SH", "600519.ss"} {
+		sec, err := Parse(input)
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, Security{Symbol: "600519", Exchange: SSE, Board: BoardMain, Type: TypeStock}, sec, input)
+		}
+	}
+
+	sec, err := Parse("0.000001")
+	assert.Nil(t, err)
+	assert.Equal(t, "sz000001", sec.Prefixed())
+	assert.Equal(t, "0.000001", sec.SecID())
+	assert.Equal(t, "000001.SZ", sec.Suffixed())
+
+	for _, input := range []string{"", "60051", "hk00700", "6005190", "abcdef", "600519.HK", "2.600519", "700001"} {
+		_, err := Parse(input)
+		assert.NotNil(t, err, input)
+	}
+}
+
+func TestParseBoards(t *testing.T) {
+	cases := []struct {
+		input    string
+		prefixed string
+		board    Board
+		typ      Type
+	}{
+		{"000001", "sz000001", BoardMain, TypeStock},
+		{"002594", "sz002594", BoardMain, TypeStock},
+		{"300750", "sz300750", BoardChiNext, TypeStock},
+		{"301236", "sz301236", BoardChiNext, TypeStock},
+		{"688981", "sh688981", BoardSTAR, TypeStock},
+		{"689009", "sh689009", BoardSTAR, TypeStock},
+		{"830799", "bj830799", BoardBSE, TypeStock},
+		{"430047", "bj430047", BoardBSE, TypeStock},
+		{"920001", "bj920001", BoardBSE, TypeStock},
+		{"0.920001", "bj920001", BoardBSE, TypeStock},
+		{"510300", "sh510300", BoardETF, TypeETF},
+		{"588000", "sh588000", BoardETF, TypeETF},
+		{"159915", "sz159915", BoardETF, TypeETF},
+		{"399006", "sz399006", BoardIndex, TypeIndex},
+		{"sh000001", "sh000001", BoardIndex, TypeIndex},
+		{"000688.SH", "sh000688", BoardIndex, TypeIndex},
+		{"bj899050", "bj899050", BoardIndex, TypeIndex},
+	}
+	for _, c := range cases {
+		sec, err := Parse(c.input)
+		if assert.Nil(t, err, c.input) {
+			assert.Equal(t, c.prefixed, sec.Prefixed(), c.input)
+			assert.Equal(t, c.board, sec.Board, c.input)
+			assert.Equal(t, c.typ, sec.Type, c.input)
+		}
+	}
+}
+
+func TestParseIndex(t *testing.T) {
+	sec, err := ParseIndex("000001")
+	assert.Nil(t, err)
+	assert.Equal(t, "sh000001", sec.Prefixed())
+	assert.Equal(t, BoardIndex, sec.Board)
+
+	sec, err = ParseIndex("399006")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.399006", sec.SecID())
+
+	sec, err = ParseIndex("899050")
+	assert.Nil(t, err)
+	assert.Equal(t, "bj899050", sec.Prefixed())
+
+	// Explicit exchanges win over the index preference
+	sec, err = ParseIndex("sz000001")
+	assert.Nil(t, err)
+	assert.Equal(t, BoardMain, sec.Board)
+
+	// Codes that cannot be an index fall back to the stock rules
+	sec, err = ParseIndex("600519")
+	assert.Nil(t, err)
+	assert.Equal(t, TypeStock, sec.Type)
+}
+
+func TestPriceLimit(t *testing.T) {
+	mustParse := func(code string) Security {
+		sec, err := Parse(code)
+		assert.Nil(t, err)
+		return sec
+	}
+
+	assert.Equal(t, 10.0, mustParse("600519").PriceLimit("贵州茅台"))
+	assert.Equal(t, 5.0, mustParse("600000").PriceLimit("*ST 某某"))
+	assert.Equal(t, 20.0, mustParse("300750").PriceLimit(""))
+	assert.Equal(t, 20.0, mustParse("688981").PriceLimit("ST 某某"))
+	assert.Equal(t, 30.0, mustParse("830799").PriceLimit(""))
+	assert.Equal(t, 10.0, mustParse("510300").PriceLimit(""))
+	assert.Equal(t, 0.0, mustParse("sh000001").PriceLimit(""))
+
+	up, down := mustParse("600000").LimitPrices(11.55, "")
+	assert.Equal(t, 12.71, up)
+	assert.Equal(t, 10.4, down)
+
+	up, down = mustParse("300750").LimitPrices(200, "")
+	assert.Equal(t, 240.0, up)
+	assert.Equal(t, 160.0, down)
+
+	up, _ = mustParse("sh000001").LimitPrices(3000, "")
+	assert.Equal(t, 0.0, up)
+}
+
+func TestIsST(t *testing.T) {
+	for _, name := range []string{"ST华微", "*ST 某某", "S*ST前锋", "SST中纺", " st明诚"} {
+		assert.True(t, IsST(name), name)
+	}
+	// Latin letters elsewhere in the name are no risk warning
+	for _, name := range []string{"贵州茅台", "TCL科技", "BEST某某", "某某STAR", ""} {
+		assert.False(t, IsST(name), name)
+	}
+}
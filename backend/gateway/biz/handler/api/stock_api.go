@@ -5,7 +5,9 @@ package api
 import (
 	"context"
 	"io"
+	"strings"
 
+	"stock_assistant/backend/common/symbol"
 	api "stock_assistant/backend/gateway/biz/model/api"
 	"stock_assistant/backend/gateway/biz/rpc"
 	"stock_assistant/backend/gateway/kitex_gen/ai"
@@ -27,9 +29,14 @@ func GetRealtime(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
 	// Call Stock Service
 	rpcReq := &stock.GetRealtimeRequest{
-		Code: req.Code,
+		Code: code,
 	}
 	rpcResp, err := rpc.StockClient.GetRealtime(ctx, rpcReq)
 	if err != nil {
@@ -38,17 +45,58 @@ func GetRealtime(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// Map response
+	resp := toRealtimeResponse(rpcResp.Stock)
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+func toRealtimeResponse(info *stock.StockInfo) *api.RealtimeResponse {
 	resp := &api.RealtimeResponse{}
-	if rpcResp.Stock != nil {
-		resp.Code = rpcResp.Stock.Code
-		resp.Name = rpcResp.Stock.Name
-		resp.CurrentPrice = rpcResp.Stock.CurrentPrice
-		resp.ChangePercent = rpcResp.Stock.ChangePercent
-		resp.Volume = rpcResp.Stock.Volume
-		resp.Timestamp = rpcResp.Stock.Timestamp
+	if info != nil {
+		resp.Code = info.Code
+		resp.Name = info.Name
+		resp.CurrentPrice = info.CurrentPrice
+		resp.ChangePercent = info.ChangePercent
+		resp.Volume = info.Volume
+		resp.Timestamp = info.Timestamp
+		resp.Source = info.Source
+		resp.Open = info.Open
+		resp.High = info.High
+		resp.Low = info.Low
+		resp.PrevClose = info.PrevClose
+		resp.Amount = info.Amount
+		resp.WeiBi = info.WeiBi
+		resp.WeiCha = info.WeiCha
+		if info.OrderBook != nil {
+			resp.OrderBook = &api.OrderBook{
+				Bids: toOrderBookLevels(info.OrderBook.Bids),
+				Asks: toOrderBookLevels(info.OrderBook.Asks),
+			}
+		}
 	}
+	return resp
+}
 
-	c.JSON(consts.StatusOK, resp)
+func toOrderBookLevels(levels []*stock.OrderBookLevel) []*api.OrderBookLevel {
+	res := make([]*api.OrderBookLevel, 0, len(levels))
+	for _, l := range levels {
+		res = append(res, &api.OrderBookLevel{
+			Price:  l.Price,
+			Volume: l.Volume,
+		})
+	}
+	return res
+}
+
+// normalizeCode parses a security code in any supported format (600519, sh600519,
+// 600519.SH...) into the prefixed form, answering 400 when it is not a valid code.
+func normalizeCode(c *app.RequestContext, code string) (string, bool) {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return "", false
+	}
+	return sec.Prefixed(), true
 }
 
 // GetFinancialReport .
@@ -62,9 +110,14 @@ func GetFinancialReport(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
 	// Call Stock Service
 	rpcReq := &stock.GetFinancialReportRequest{
-		Code: req.Code,
+		Code: code,
 	}
 	rpcResp, err := rpc.StockClient.GetFinancialReport(ctx, rpcReq)
 	if err != nil {
@@ -103,9 +156,14 @@ func GetPrediction(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
 	// Call AI Service
 	rpcReq := &ai.GetPredictionRequest{
-		Code:        req.Code,
+		Code:        code,
 		Days:        req.Days,
 		IncludeNews: req.IncludeNews,
 		Model:       req.Model,
@@ -365,3 +423,1298 @@ func AnalyzeMarket(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// GetRealtimeBatch .
+// @router /api/stocks/realtime [GET]
+func GetRealtimeBatch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetRealtimeBatchRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	var codes []string
+	for _, code := range strings.Split(req.Codes, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		c.String(consts.StatusBadRequest, "codes is required")
+		return
+	}
+
+	// Call Stock Service
+	rpcReq := &stock.GetRealtimeBatchRequest{
+		Codes: codes,
+	}
+	rpcResp, err := rpc.StockClient.GetRealtimeBatch(ctx, rpcReq)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetRealtimeBatchResponse{
+		Stocks: make([]*api.RealtimeResponse, 0, len(rpcResp.Stocks)),
+		Errors: make(map[string]string),
+	}
+	for _, info := range rpcResp.Stocks {
+		resp.Stocks = append(resp.Stocks, toRealtimeResponse(info))
+	}
+	for code, msg := range rpcResp.Errors {
+		resp.Errors[code] = msg
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetKLine .
+// @router /api/stocks/:code/kline [GET]
+func GetKLine(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetKLineRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	// Call Stock Service
+	rpcReq := &stock.GetKLineRequest{
+		Code:      code,
+		Period:    req.Period,
+		Adjust:    req.Adjust,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Limit:     req.Limit,
+	}
+	rpcResp, err := rpc.StockClient.GetKLine(ctx, rpcReq)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetKLineResponse{
+		Code: rpcResp.Code,
+		Name: rpcResp.Name,
+		Bars: make([]*api.KLineBar, 0, len(rpcResp.Bars)),
+	}
+	for _, b := range rpcResp.Bars {
+		resp.Bars = append(resp.Bars, &api.KLineBar{
+			Date:          b.Date,
+			Open:          b.Open,
+			Close:         b.Close,
+			High:          b.High,
+			Low:           b.Low,
+			Volume:        b.Volume,
+			Amount:        b.Amount,
+			ChangePercent: b.ChangePercent,
+			TurnoverRate:  b.TurnoverRate,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetIntradayTrend .
+// @router /api/stocks/:code/intraday [GET]
+func GetIntradayTrend(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetIntradayTrendRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	// Call Stock Service
+	rpcReq := &stock.GetIntradayTrendRequest{
+		Code: code,
+		Date: req.Date,
+	}
+	rpcResp, err := rpc.StockClient.GetIntradayTrend(ctx, rpcReq)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetIntradayTrendResponse{
+		Code:      rpcResp.Code,
+		Name:      rpcResp.Name,
+		Date:      rpcResp.Date,
+		PrevClose: rpcResp.PrevClose,
+		Points:    make([]*api.IntradayPoint, 0, len(rpcResp.Points)),
+	}
+	for _, p := range rpcResp.Points {
+		resp.Points = append(resp.Points, &api.IntradayPoint{
+			Time:     p.Time,
+			Price:    p.Price,
+			AvgPrice: p.AvgPrice,
+			Volume:   p.Volume,
+			Amount:   p.Amount,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetIndexOverview .
+// @router /api/market/indices [GET]
+func GetIndexOverview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetIndexOverviewRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	var codes []string
+	for _, code := range strings.Split(req.Codes, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	// Call Stock Service, an empty list means the default index set
+	rpcReq := &stock.GetIndexOverviewRequest{
+		Codes: codes,
+		Date:  req.Date,
+	}
+	rpcResp, err := rpc.StockClient.GetIndexOverview(ctx, rpcReq)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetIndexOverviewResponse{
+		Indices: make([]*api.IndexQuote, 0, len(rpcResp.Indices)),
+	}
+	for _, idx := range rpcResp.Indices {
+		resp.Indices = append(resp.Indices, &api.IndexQuote{
+			Code:          idx.Code,
+			Name:          idx.Name,
+			CurrentPrice:  idx.CurrentPrice,
+			Change:        idx.Change,
+			ChangePercent: idx.ChangePercent,
+			Amount:        idx.Amount,
+			UpCount:       idx.UpCount,
+			DownCount:     idx.DownCount,
+			FlatCount:     idx.FlatCount,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SearchSecurities .
+// @router /api/stocks/search [GET]
+func SearchSecurities(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchSecuritiesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcReq := &stock.SearchSecuritiesRequest{
+		Query: req.Query,
+		Limit: req.Limit,
+	}
+	rpcResp, err := rpc.StockClient.SearchSecurities(ctx, rpcReq)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.SearchSecuritiesResponse{
+		Securities: make([]*api.SecurityInfo, 0, len(rpcResp.Securities)),
+	}
+	for _, sec := range rpcResp.Securities {
+		resp.Securities = append(resp.Securities, &api.SecurityInfo{
+			Code:        sec.Code,
+			Symbol:      sec.Symbol,
+			Exchange:    sec.Exchange,
+			Board:       sec.Board,
+			Type:        sec.Type,
+			Name:        sec.Name,
+			Pinyin:      sec.Pinyin,
+			ListingDate: sec.ListingDate,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetSentimentSnapshot .
+// @router /api/market/sentiment [GET]
+func GetSentimentSnapshot(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetSentimentSnapshotRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetSentimentSnapshot(ctx, &stock.GetSentimentSnapshotRequest{
+		Date: req.Date,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := new(api.GetSentimentSnapshotResponse)
+	if snap := rpcResp.Snapshot; snap != nil {
+		resp.Date = snap.Date
+		resp.LimitUpCount = snap.LimitUpCount
+		resp.BrokenCount = snap.BrokenCount
+		resp.LimitDownCount = snap.LimitDownCount
+		resp.BrokenRate = snap.BrokenRate
+		resp.HighestBoard = snap.HighestBoard
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetSentimentHistory .
+// @router /api/market/sentiment/history [GET]
+func GetSentimentHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetSentimentHistoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetSentimentHistory(ctx, &stock.GetSentimentHistoryRequest{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := new(api.GetSentimentHistoryResponse)
+	resp.Days = make([]*api.SentimentDay, 0, len(rpcResp.Days))
+	for _, day := range rpcResp.Days {
+		resp.Days = append(resp.Days, &api.SentimentDay{
+			Date:           day.Date,
+			LimitUpCount:   day.LimitUpCount,
+			BrokenCount:    day.BrokenCount,
+			LimitDownCount: day.LimitDownCount,
+			BrokenRate:     day.BrokenRate,
+			HighestBoard:   day.HighestBoard,
+			SentimentScore: day.SentimentScore,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetSectorTimeline .
+// @router /api/market/sector/timeline [GET]
+func GetSectorTimeline(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetSectorTimelineRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetSectorTimeline(ctx, &stock.GetSectorTimelineRequest{
+		SectorCode: req.SectorCode,
+		Date:       req.Date,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetSectorTimelineResponse{
+		SectorCode: rpcResp.SectorCode,
+		Name:       rpcResp.Name,
+		Type:       rpcResp.Type,
+		Date:       rpcResp.Date,
+		Points:     make([]*api.SectorTimelinePoint, 0, len(rpcResp.Points)),
+	}
+	for _, p := range rpcResp.Points {
+		resp.Points = append(resp.Points, &api.SectorTimelinePoint{
+			Time:          p.Time,
+			Rank:          p.Rank,
+			Total:         p.Total,
+			ChangePercent: p.ChangePercent,
+			NetInflow:     p.NetInflow,
+			TopStockName:  p.TopStockName,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetSectorRotation .
+// @router /api/market/sector/rotation [GET]
+func GetSectorRotation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetSectorRotationRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetSectorRotation(ctx, &stock.GetSectorRotationRequest{
+		Days:  req.Days,
+		Type:  req.Type,
+		Limit: req.Limit,
+		Date:  req.Date,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetSectorRotationResponse{
+		Dates:   rpcResp.Dates,
+		Sectors: make([]*api.SectorRotationItem, 0, len(rpcResp.Sectors)),
+	}
+	for _, item := range rpcResp.Sectors {
+		resp.Sectors = append(resp.Sectors, &api.SectorRotationItem{
+			Code:                item.Code,
+			Name:                item.Name,
+			Type:                item.Type,
+			Ranks:               item.Ranks,
+			LatestRank:          item.LatestRank,
+			Total:               item.Total,
+			CumulativeChange:    item.CumulativeChange,
+			CumulativeNetInflow: item.CumulativeNetInflow,
+			Top10Streak:         item.Top10Streak,
+			MomentumScore:       item.MomentumScore,
+			Trend:               item.Trend,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetLimitUpLadder .
+// @router /api/market/limit_up/ladder [GET]
+func GetLimitUpLadder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetLimitUpLadderRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetLimitUpLadder(ctx, &stock.GetLimitUpLadderRequest{
+		Date: req.Date,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetLimitUpLadderResponse{
+		Date:     rpcResp.Date,
+		PrevDate: rpcResp.PrevDate,
+		Levels:   make([]*api.LimitUpLadderLevel, 0, len(rpcResp.Levels)),
+	}
+	for _, l := range rpcResp.Levels {
+		level := &api.LimitUpLadderLevel{
+			Height:        l.Height,
+			Stocks:        make([]*api.LadderStock, 0, len(l.Stocks)),
+			PrevCount:     l.PrevCount,
+			PromotedCount: l.PromotedCount,
+			PromotionRate: l.PromotionRate,
+		}
+		for _, st := range l.Stocks {
+			level.Stocks = append(level.Stocks, &api.LadderStock{
+				Code:          st.Code,
+				Name:          st.Name,
+				Price:         st.Price,
+				ChangePercent: st.ChangePercent,
+				Reason:        st.Reason,
+				FirstSealTime: st.FirstSealTime,
+				LastSealTime:  st.LastSealTime,
+				SealAmount:    st.SealAmount,
+				OpenCount:     st.OpenCount,
+				TurnoverRate:  st.TurnoverRate,
+				Concept:       st.Concept,
+			})
+		}
+		resp.Levels = append(resp.Levels, level)
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetMarketBreadth .
+// @router /api/market/breadth [GET]
+func GetMarketBreadth(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetMarketBreadthRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetMarketBreadth(ctx, &stock.GetMarketBreadthRequest{
+		Date:        req.Date,
+		HistoryDays: req.HistoryDays,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetMarketBreadthResponse{
+		Breadth:             toAPIBreadth(rpcResp.Breadth),
+		PrevAmount:          rpcResp.PrevAmount,
+		AmountChangePercent: rpcResp.AmountChangePercent,
+		History:             make([]*api.MarketBreadth, 0, len(rpcResp.History)),
+	}
+	for _, b := range rpcResp.History {
+		resp.History = append(resp.History, toAPIBreadth(b))
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+func toAPIBreadth(b *stock.MarketBreadth) *api.MarketBreadth {
+	if b == nil {
+		return nil
+	}
+	result := &api.MarketBreadth{
+		Date:      b.Date,
+		Advance:   b.Advance,
+		Decline:   b.Decline,
+		Flat:      b.Flat,
+		Suspended: b.Suspended,
+		LimitUp:   b.LimitUp,
+		LimitDown: b.LimitDown,
+		Amount:    b.Amount,
+		Buckets:   make([]*api.BreadthBucket, 0, len(b.Buckets)),
+		Boards:    make([]*api.BreadthBoard, 0, len(b.Boards)),
+	}
+	for _, bucket := range b.Buckets {
+		result.Buckets = append(result.Buckets, &api.BreadthBucket{Label: bucket.Label, Count: bucket.Count})
+	}
+	for _, board := range b.Boards {
+		result.Boards = append(result.Boards, &api.BreadthBoard{
+			Board:     board.Board,
+			Advance:   board.Advance,
+			Decline:   board.Decline,
+			Flat:      board.Flat,
+			LimitUp:   board.LimitUp,
+			LimitDown: board.LimitDown,
+			Amount:    board.Amount,
+		})
+	}
+	return result
+}
+
+// GetMarketTemperature .
+// @router /api/market/temperature [GET]
+func GetMarketTemperature(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetMarketTemperatureRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetMarketTemperature(ctx, &stock.GetMarketTemperatureRequest{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := new(api.GetMarketTemperatureResponse)
+	resp.Days = make([]*api.TemperatureDay, 0, len(rpcResp.Days))
+	for _, day := range rpcResp.Days {
+		d := &api.TemperatureDay{
+			Date:       day.Date,
+			Score:      day.Score,
+			Components: make([]*api.TemperatureComponent, 0, len(day.Components)),
+		}
+		for _, comp := range day.Components {
+			d.Components = append(d.Components, &api.TemperatureComponent{
+				Name:   comp.Name,
+				Value:  comp.Value,
+				Weight: comp.Weight,
+			})
+		}
+		resp.Days = append(resp.Days, d)
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetStockFundFlow .
+// @router /api/stocks/:code/fundflow [GET]
+func GetStockFundFlow(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetStockFundFlowRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetStockFundFlow(ctx, &stock.GetStockFundFlowRequest{
+		Code: code,
+		Days: req.Days,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetStockFundFlowResponse{
+		Code:         rpcResp.Code,
+		Name:         rpcResp.Name,
+		Days:         make([]*api.FundFlowDay, 0, len(rpcResp.Days)),
+		IntradayDate: rpcResp.IntradayDate,
+		Intraday:     make([]*api.FundFlowMinute, 0, len(rpcResp.Intraday)),
+	}
+	for _, d := range rpcResp.Days {
+		resp.Days = append(resp.Days, &api.FundFlowDay{
+			Date:          d.Date,
+			MainNet:       d.MainNet,
+			SuperLargeNet: d.SuperLargeNet,
+			LargeNet:      d.LargeNet,
+			MediumNet:     d.MediumNet,
+			SmallNet:      d.SmallNet,
+			MainNetRatio:  d.MainNetRatio,
+			Close:         d.Close,
+			ChangePercent: d.ChangePercent,
+		})
+	}
+	for _, m := range rpcResp.Intraday {
+		resp.Intraday = append(resp.Intraday, &api.FundFlowMinute{
+			Time:          m.Time,
+			MainNet:       m.MainNet,
+			SuperLargeNet: m.SuperLargeNet,
+			LargeNet:      m.LargeNet,
+			MediumNet:     m.MediumNet,
+			SmallNet:      m.SmallNet,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetSectorFundFlow .
+// @router /api/market/sector/fundflow [GET]
+func GetSectorFundFlow(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetSectorFundFlowRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call Stock Service
+	rpcResp, err := rpc.StockClient.GetSectorFundFlow(ctx, &stock.GetSectorFundFlowRequest{
+		Type:        req.Type,
+		Period:      req.Period,
+		Limit:       req.Limit,
+		HistoryDays: req.HistoryDays,
+		MinStreak:   req.MinStreak,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Map response
+	resp := &api.GetSectorFundFlowResponse{
+		Type:    rpcResp.Type,
+		Period:  rpcResp.Period,
+		Dates:   rpcResp.Dates,
+		Sectors: make([]*api.SectorFundFlowItem, 0, len(rpcResp.Sectors)),
+	}
+	for _, item := range rpcResp.Sectors {
+		resp.Sectors = append(resp.Sectors, &api.SectorFundFlowItem{
+			Code:           item.Code,
+			Name:           item.Name,
+			Type:           item.Type,
+			ChangePercent:  item.ChangePercent,
+			MainNetInflow:  item.MainNetInflow,
+			MainNetRatio:   item.MainNetRatio,
+			TopStockName:   item.TopStockName,
+			DailyNetInflow: item.DailyNetInflow,
+			InflowStreak:   item.InflowStreak,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetNorthboundHolding .
+// @router /api/stocks/:code/northbound [GET]
+func GetNorthboundHolding(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNorthboundHoldingRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetNorthboundHolding(ctx, &stock.GetNorthboundHoldingRequest{
+		Code: code,
+		Days: req.Days,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetNorthboundHoldingResponse{
+		Code:     rpcResp.Code,
+		Name:     rpcResp.Name,
+		Holdings: make([]*api.NorthboundHolding, 0, len(rpcResp.Holdings)),
+	}
+	for _, h := range rpcResp.Holdings {
+		resp.Holdings = append(resp.Holdings, &api.NorthboundHolding{
+			Date:            h.Date,
+			Shares:          h.Shares,
+			MarketCap:       h.MarketCap,
+			FloatRatio:      h.FloatRatio,
+			SharesChange:    h.SharesChange,
+			MarketCapChange: h.MarketCapChange,
+			Close:           h.Close,
+			ChangePercent:   h.ChangePercent,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetNorthboundFlow .
+// @router /api/market/northbound [GET]
+func GetNorthboundFlow(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNorthboundFlowRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetNorthboundFlow(ctx, &stock.GetNorthboundFlowRequest{
+		Days: req.Days,
+		Date: req.Date,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetNorthboundFlowResponse{
+		Days:         make([]*api.NorthboundDay, 0, len(rpcResp.Days)),
+		IntradayDate: rpcResp.IntradayDate,
+		Intraday:     make([]*api.NorthboundMinute, 0, len(rpcResp.Intraday)),
+	}
+	for _, d := range rpcResp.Days {
+		resp.Days = append(resp.Days, &api.NorthboundDay{
+			Date:         d.Date,
+			NetBuy:       d.NetBuy,
+			ShNetBuy:     d.ShNetBuy,
+			SzNetBuy:     d.SzNetBuy,
+			DealAmount:   d.DealAmount,
+			ShDealAmount: d.ShDealAmount,
+			SzDealAmount: d.SzDealAmount,
+		})
+	}
+	for _, m := range rpcResp.Intraday {
+		resp.Intraday = append(resp.Intraday, &api.NorthboundMinute{
+			Time:     m.Time,
+			NetBuy:   m.NetBuy,
+			ShNetBuy: m.ShNetBuy,
+			SzNetBuy: m.SzNetBuy,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetNorthboundTopStocks .
+// @router /api/market/northbound/top [GET]
+func GetNorthboundTopStocks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNorthboundTopStocksRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetNorthboundTopStocks(ctx, &stock.GetNorthboundTopStocksRequest{
+		Date: req.Date,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetNorthboundTopStocksResponse{
+		Date:   rpcResp.Date,
+		Stocks: make([]*api.NorthboundTopStock, 0, len(rpcResp.Stocks)),
+	}
+	for _, st := range rpcResp.Stocks {
+		resp.Stocks = append(resp.Stocks, &api.NorthboundTopStock{
+			Connect:       st.Connect,
+			Rank:          st.Rank,
+			Code:          st.Code,
+			Name:          st.Name,
+			Close:         st.Close,
+			ChangePercent: st.ChangePercent,
+			NetBuy:        st.NetBuy,
+			BuyAmount:     st.BuyAmount,
+			SellAmount:    st.SellAmount,
+			DealAmount:    st.DealAmount,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetMarginTrading .
+// @router /api/stocks/:code/margin [GET]
+func GetMarginTrading(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetMarginTradingRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetMarginTrading(ctx, &stock.GetMarginTradingRequest{
+		Code: code,
+		Days: req.Days,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetMarginTradingResponse{
+		Code: rpcResp.Code,
+		Name: rpcResp.Name,
+		Days: toAPIMarginDays(rpcResp.Days),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetMarketMarginSummary .
+// @router /api/market/margin [GET]
+func GetMarketMarginSummary(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetMarketMarginSummaryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetMarketMarginSummary(ctx, &stock.GetMarketMarginSummaryRequest{
+		Days: req.Days,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetMarketMarginSummaryResponse{
+		Days: toAPIMarginDays(rpcResp.Days),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+func toAPIMarginDays(days []*stock.MarginDay) []*api.MarginDay {
+	result := make([]*api.MarginDay, 0, len(days))
+	for _, d := range days {
+		result = append(result, &api.MarginDay{
+			Date:                   d.Date,
+			FinancingBalance:       d.FinancingBalance,
+			FinancingBuy:           d.FinancingBuy,
+			FinancingRepay:         d.FinancingRepay,
+			FinancingNetBuy:        d.FinancingNetBuy,
+			LendingBalance:         d.LendingBalance,
+			LendingVolume:          d.LendingVolume,
+			TotalBalance:           d.TotalBalance,
+			FinancingBalanceChange: d.FinancingBalanceChange,
+			LendingBalanceChange:   d.LendingBalanceChange,
+			TotalBalanceChange:     d.TotalBalanceChange,
+			Close:                  d.Close,
+			ChangePercent:          d.ChangePercent,
+		})
+	}
+	return result
+}
+
+// GetFinancialStatements .
+// @router /api/stocks/:code/financial/statements [GET]
+func GetFinancialStatements(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetFinancialStatementsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetFinancialStatements(ctx, &stock.GetFinancialStatementsRequest{
+		Code:    code,
+		View:    req.View,
+		Periods: req.Periods,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetFinancialStatementsResponse{
+		Code:    rpcResp.Code,
+		Name:    rpcResp.Name,
+		View:    rpcResp.View,
+		Periods: make([]*api.FinancialPeriod, 0, len(rpcResp.Periods)),
+	}
+	for _, p := range rpcResp.Periods {
+		resp.Periods = append(resp.Periods, toAPIFinancialPeriod(p))
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+func toAPIFinancialPeriod(p *stock.FinancialPeriod) *api.FinancialPeriod {
+	result := &api.FinancialPeriod{
+		ReportDate: p.ReportDate,
+		ReportType: p.ReportType,
+	}
+	if in := p.Income; in != nil {
+		result.Income = &api.IncomeStatement{
+			TotalRevenue:      in.TotalRevenue,
+			Revenue:           in.Revenue,
+			OperatingCost:     in.OperatingCost,
+			SellingExpense:    in.SellingExpense,
+			AdminExpense:      in.AdminExpense,
+			RdExpense:         in.RdExpense,
+			FinanceExpense:    in.FinanceExpense,
+			OperatingProfit:   in.OperatingProfit,
+			TotalProfit:       in.TotalProfit,
+			IncomeTax:         in.IncomeTax,
+			NetProfit:         in.NetProfit,
+			ParentNetProfit:   in.ParentNetProfit,
+			DeductedNetProfit: in.DeductedNetProfit,
+			Eps:               in.Eps,
+		}
+	}
+	if bs := p.Balance; bs != nil {
+		result.Balance = &api.BalanceSheet{
+			TotalAssets:        bs.TotalAssets,
+			CurrentAssets:      bs.CurrentAssets,
+			Cash:               bs.Cash,
+			Receivables:        bs.Receivables,
+			Inventory:          bs.Inventory,
+			FixedAssets:        bs.FixedAssets,
+			Goodwill:           bs.Goodwill,
+			TotalLiabilities:   bs.TotalLiabilities,
+			CurrentLiabilities: bs.CurrentLiabilities,
+			ShortTermLoans:     bs.ShortTermLoans,
+			LongTermLoans:      bs.LongTermLoans,
+			TotalEquity:        bs.TotalEquity,
+			ParentEquity:       bs.ParentEquity,
+		}
+	}
+	if cf := p.CashFlow; cf != nil {
+		result.CashFlow = &api.CashFlowStatement{
+			OperatingCashFlow: cf.OperatingCashFlow,
+			InvestingCashFlow: cf.InvestingCashFlow,
+			FinancingCashFlow: cf.FinancingCashFlow,
+			Capex:             cf.Capex,
+			NetCashChange:     cf.NetCashChange,
+			EndingCash:        cf.EndingCash,
+		}
+	}
+	if r := p.Ratios; r != nil {
+		result.Ratios = &api.FinancialRatios{
+			Roe:            r.Roe,
+			GrossMargin:    r.GrossMargin,
+			NetMargin:      r.NetMargin,
+			DebtRatio:      r.DebtRatio,
+			OcfToNetProfit: r.OcfToNetProfit,
+		}
+	}
+	return result
+}
+
+// GetValuation .
+// @router /api/stocks/:code/valuation [GET]
+func GetValuation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetValuationRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetValuation(ctx, &stock.GetValuationRequest{
+		Code:  code,
+		Years: req.Years,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &api.GetValuationResponse{
+		Code:     rpcResp.Code,
+		Name:     rpcResp.Name,
+		Industry: rpcResp.Industry,
+		Years:    rpcResp.Years,
+		Days:     make([]*api.ValuationDay, 0, len(rpcResp.Days)),
+		PeTtm:    toAPIValuationBand(rpcResp.PeTtm),
+		Pb:       toAPIValuationBand(rpcResp.Pb),
+		PsTtm:    toAPIValuationBand(rpcResp.PsTtm),
+	}
+	for _, d := range rpcResp.Days {
+		resp.Days = append(resp.Days, &api.ValuationDay{
+			Date:      d.Date,
+			Close:     d.Close,
+			PeTtm:     d.PeTtm,
+			Pb:        d.Pb,
+			PsTtm:     d.PsTtm,
+			MarketCap: d.MarketCap,
+		})
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+func toAPIValuationBand(b *stock.ValuationBand) *api.ValuationBand {
+	if b == nil {
+		return nil
+	}
+	return &api.ValuationBand{
+		Current:        b.Current,
+		Percentile:     b.Percentile,
+		Min:            b.Min,
+		Median:         b.Median,
+		Max:            b.Max,
+		IndustryMedian: b.IndustryMedian,
+	}
+}
+
+// GetStockEarnings .
+// @router /api/stocks/:code/earnings [GET]
+func GetStockEarnings(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetStockEarningsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetEarningsCalendar(ctx, &stock.GetEarningsCalendarRequest{
+		Code:  code,
+		Start: req.Start,
+		End:   req.End,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, toAPIEarningsCalendar(rpcResp))
+}
+
+// GetEarningsCalendar .
+// @router /api/market/earnings [GET]
+func GetEarningsCalendar(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetEarningsCalendarRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetEarningsCalendar(ctx, &stock.GetEarningsCalendarRequest{
+		Start: req.Start,
+		End:   req.End,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, toAPIEarningsCalendar(rpcResp))
+}
+
+func toAPIEarningsCalendar(r *stock.GetEarningsCalendarResponse) *api.GetEarningsCalendarResponse {
+	resp := &api.GetEarningsCalendarResponse{
+		Start:       r.Start,
+		End:         r.End,
+		Disclosures: make([]*api.EarningsDisclosure, 0, len(r.Disclosures)),
+		Previews:    make([]*api.EarningsPreview, 0, len(r.Previews)),
+		Expresses:   make([]*api.EarningsExpress, 0, len(r.Expresses)),
+	}
+	for _, d := range r.Disclosures {
+		resp.Disclosures = append(resp.Disclosures, &api.EarningsDisclosure{
+			Code:               d.Code,
+			Name:               d.Name,
+			ReportDate:         d.ReportDate,
+			ReportType:         d.ReportType,
+			ScheduledDate:      d.ScheduledDate,
+			FirstScheduledDate: d.FirstScheduledDate,
+			Reschedules:        d.Reschedules,
+			ActualDate:         d.ActualDate,
+		})
+	}
+	for _, p := range r.Previews {
+		resp.Previews = append(resp.Previews, &api.EarningsPreview{
+			Code:        p.Code,
+			Name:        p.Name,
+			ReportDate:  p.ReportDate,
+			NoticeDate:  p.NoticeDate,
+			Indicator:   p.Indicator,
+			Type:        p.Type,
+			Lower:       p.Lower,
+			Upper:       p.Upper,
+			ChangeLower: p.ChangeLower,
+			ChangeUpper: p.ChangeUpper,
+			LastYear:    p.LastYear,
+			Reason:      p.Reason,
+		})
+	}
+	for _, e := range r.Expresses {
+		resp.Expresses = append(resp.Expresses, &api.EarningsExpress{
+			Code:         e.Code,
+			Name:         e.Name,
+			ReportDate:   e.ReportDate,
+			NoticeDate:   e.NoticeDate,
+			Revenue:      e.Revenue,
+			RevenueYoy:   e.RevenueYoy,
+			NetProfit:    e.NetProfit,
+			NetProfitYoy: e.NetProfitYoy,
+			Eps:          e.Eps,
+			Roe:          e.Roe,
+		})
+	}
+	return resp
+}
+
+// GetShareUnlocks .
+// @router /api/stocks/:code/unlocks [GET]
+func GetShareUnlocks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetShareUnlocksRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetShareUnlocks(ctx, &stock.GetShareUnlocksRequest{
+		Code:  code,
+		Start: req.Start,
+		End:   req.End,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, &api.GetShareUnlocksResponse{
+		Code:    rpcResp.Code,
+		Start:   rpcResp.Start,
+		End:     rpcResp.End,
+		Unlocks: toAPIShareUnlocks(rpcResp.Unlocks),
+	})
+}
+
+// GetDividends .
+// @router /api/stocks/:code/dividends [GET]
+func GetDividends(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetDividendsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, ok := normalizeCode(c, req.Code)
+	if !ok {
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetDividends(ctx, &stock.GetDividendsRequest{
+		Code:  code,
+		Start: req.Start,
+		End:   req.End,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, &api.GetDividendsResponse{
+		Code:      rpcResp.Code,
+		Start:     rpcResp.Start,
+		End:       rpcResp.End,
+		Dividends: toAPIDividendPlans(rpcResp.Dividends),
+	})
+}
+
+// GetCorporateActionCalendar .
+// @router /api/market/corporate_actions [GET]
+func GetCorporateActionCalendar(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetCorporateActionCalendarRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	rpcResp, err := rpc.StockClient.GetCorporateActionCalendar(ctx, &stock.GetCorporateActionCalendarRequest{
+		Start: req.Start,
+		End:   req.End,
+	})
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, &api.GetCorporateActionCalendarResponse{
+		Start:     rpcResp.Start,
+		End:       rpcResp.End,
+		Unlocks:   toAPIShareUnlocks(rpcResp.Unlocks),
+		Dividends: toAPIDividendPlans(rpcResp.Dividends),
+	})
+}
+
+func toAPIShareUnlocks(unlocks []*stock.ShareUnlock) []*api.ShareUnlock {
+	list := make([]*api.ShareUnlock, 0, len(unlocks))
+	for _, u := range unlocks {
+		list = append(list, &api.ShareUnlock{
+			Code:       u.Code,
+			Name:       u.Name,
+			Date:       u.Date,
+			Shares:     u.Shares,
+			MarketCap:  u.MarketCap,
+			FloatRatio: u.FloatRatio,
+			HolderType: u.HolderType,
+		})
+	}
+	return list
+}
+
+func toAPIDividendPlans(plans []*stock.DividendPlan) []*api.DividendPlan {
+	list := make([]*api.DividendPlan, 0, len(plans))
+	for _, d := range plans {
+		list = append(list, &api.DividendPlan{
+			Code:           d.Code,
+			Name:           d.Name,
+			ReportDate:     d.ReportDate,
+			NoticeDate:     d.NoticeDate,
+			RecordDate:     d.RecordDate,
+			ExDate:         d.ExDate,
+			CashPerTen:     d.CashPerTen,
+			BonusPerTen:    d.BonusPerTen,
+			TransferPerTen: d.TransferPerTen,
+			Progress:       d.Progress,
+			Plan:           d.Plan,
+		})
+	}
+	return list
+}
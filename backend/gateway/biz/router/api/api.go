@@ -26,7 +26,29 @@ func Register(r *server.Hertz) {
 		{
 			_market := _api.Group("/market", _marketMw()...)
 			_market.POST("/analysis", append(_analyzemarketMw(), api.AnalyzeMarket)...)
+			_market.GET("/breadth", append(_getmarketbreadthMw(), api.GetMarketBreadth)...)
+			_market.GET("/corporate_actions", append(_getcorporateactioncalendarMw(), api.GetCorporateActionCalendar)...)
+			_market.GET("/earnings", append(_getearningscalendarMw(), api.GetEarningsCalendar)...)
+			_market.GET("/indices", append(_getindexoverviewMw(), api.GetIndexOverview)...)
+			_market.GET("/margin", append(_getmarketmarginsummaryMw(), api.GetMarketMarginSummary)...)
+			_market.GET("/northbound", append(_getnorthboundflowMw(), api.GetNorthboundFlow)...)
+			_northbound := _market.Group("/northbound", _northboundMw()...)
+			_northbound.GET("/top", append(_getnorthboundtopstocksMw(), api.GetNorthboundTopStocks)...)
 			_market.POST("/review", append(_marketreviewMw(), api.MarketReview)...)
+			_market.GET("/sentiment", append(_getsentimentsnapshotMw(), api.GetSentimentSnapshot)...)
+			_sentiment := _market.Group("/sentiment", _sentimentMw()...)
+			_sentiment.GET("/history", append(_getsentimenthistoryMw(), api.GetSentimentHistory)...)
+			_market.GET("/temperature", append(_getmarkettemperatureMw(), api.GetMarketTemperature)...)
+			{
+				_limit_up := _market.Group("/limit_up", _limit_upMw()...)
+				_limit_up.GET("/ladder", append(_getlimitupladderMw(), api.GetLimitUpLadder)...)
+			}
+			{
+				_sector := _market.Group("/sector", _sectorMw()...)
+				_sector.GET("/fundflow", append(_getsectorfundflowMw(), api.GetSectorFundFlow)...)
+				_sector.GET("/rotation", append(_getsectorrotationMw(), api.GetSectorRotation)...)
+				_sector.GET("/timeline", append(_getsectortimelineMw(), api.GetSectorTimeline)...)
+			}
 		}
 		{
 			_prediction := _api.Group("/prediction", _predictionMw()...)
@@ -39,16 +61,29 @@ func Register(r *server.Hertz) {
 				_dragontiger.GET("/list", append(_getdragontigerlistMw(), api.GetDragonTigerList)...)
 			}
 			{
-				_sector := _stock.Group("/sector", _sectorMw()...)
-				_sector.GET("/stocks", append(_getsectorstocksMw(), api.GetSectorStocks)...)
+				_sector0 := _stock.Group("/sector", _sector0Mw()...)
+				_sector0.GET("/stocks", append(_getsectorstocksMw(), api.GetSectorStocks)...)
 			}
 		}
 		{
 			_stocks := _api.Group("/stocks", _stocksMw()...)
+			_stocks.GET("/realtime", append(_getrealtimebatchMw(), api.GetRealtimeBatch)...)
+			_stocks.GET("/search", append(_searchsecuritiesMw(), api.SearchSecurities)...)
 			{
 				_code := _stocks.Group("/:code", _codeMw()...)
+				_code.GET("/dividends", append(_getdividendsMw(), api.GetDividends)...)
+				_code.GET("/earnings", append(_getstockearningsMw(), api.GetStockEarnings)...)
 				_code.GET("/financial", append(_getfinancialreportMw(), api.GetFinancialReport)...)
+				_financial := _code.Group("/financial", _financialMw()...)
+				_financial.GET("/statements", append(_getfinancialstatementsMw(), api.GetFinancialStatements)...)
+				_code.GET("/fundflow", append(_getstockfundflowMw(), api.GetStockFundFlow)...)
+				_code.GET("/intraday", append(_getintradaytrendMw(), api.GetIntradayTrend)...)
+				_code.GET("/kline", append(_getklineMw(), api.GetKLine)...)
+				_code.GET("/margin", append(_getmargintradingMw(), api.GetMarginTrading)...)
+				_code.GET("/northbound", append(_getnorthboundholdingMw(), api.GetNorthboundHolding)...)
 				_code.GET("/realtime", append(_getrealtimeMw(), api.GetRealtime)...)
+				_code.GET("/unlocks", append(_getshareunlocksMw(), api.GetShareUnlocks)...)
+				_code.GET("/valuation", append(_getvaluationMw(), api.GetValuation)...)
 			}
 		}
 	}
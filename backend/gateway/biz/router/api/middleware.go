@@ -95,3 +95,158 @@ func _analyzemarketMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _getrealtimebatchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getklineMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getintradaytrendMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getindexoverviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchsecuritiesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getsentimentsnapshotMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sentimentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getsentimenthistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getsectortimelineMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sector0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getsectorrotationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _limit_upMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getlimitupladderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmarketbreadthMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmarkettemperatureMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getstockfundflowMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getsectorfundflowMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _northboundMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnorthboundflowMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnorthboundtopstocksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnorthboundholdingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmarketmarginsummaryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmargintradingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _financialMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfinancialstatementsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getvaluationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getearningscalendarMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getstockearningsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcorporateactioncalendarMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getdividendsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getshareunlocksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
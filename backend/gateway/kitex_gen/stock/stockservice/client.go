@@ -1,4 +1,4 @@
-// Code generated by Kitex v1.20.0. DO NOT EDIT.
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
 
 package stockservice
 
@@ -12,9 +12,36 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	GetRealtime(ctx context.Context, req *stock.GetRealtimeRequest, callOptions ...callopt.Option) (r *stock.GetRealtimeResponse, err error)
+	GetRealtimeBatch(ctx context.Context, req *stock.GetRealtimeBatchRequest, callOptions ...callopt.Option) (r *stock.GetRealtimeBatchResponse, err error)
 	GetFinancialReport(ctx context.Context, req *stock.GetFinancialReportRequest, callOptions ...callopt.Option) (r *stock.GetFinancialReportResponse, err error)
+	GetFinancialStatements(ctx context.Context, req *stock.GetFinancialStatementsRequest, callOptions ...callopt.Option) (r *stock.GetFinancialStatementsResponse, err error)
+	GetValuation(ctx context.Context, req *stock.GetValuationRequest, callOptions ...callopt.Option) (r *stock.GetValuationResponse, err error)
+	GetEarningsCalendar(ctx context.Context, req *stock.GetEarningsCalendarRequest, callOptions ...callopt.Option) (r *stock.GetEarningsCalendarResponse, err error)
+	GetShareUnlocks(ctx context.Context, req *stock.GetShareUnlocksRequest, callOptions ...callopt.Option) (r *stock.GetShareUnlocksResponse, err error)
+	GetDividends(ctx context.Context, req *stock.GetDividendsRequest, callOptions ...callopt.Option) (r *stock.GetDividendsResponse, err error)
+	GetCorporateActionCalendar(ctx context.Context, req *stock.GetCorporateActionCalendarRequest, callOptions ...callopt.Option) (r *stock.GetCorporateActionCalendarResponse, err error)
+	GetKLine(ctx context.Context, req *stock.GetKLineRequest, callOptions ...callopt.Option) (r *stock.GetKLineResponse, err error)
+	GetIntradayTrend(ctx context.Context, req *stock.GetIntradayTrendRequest, callOptions ...callopt.Option) (r *stock.GetIntradayTrendResponse, err error)
+	GetStockFundFlow(ctx context.Context, req *stock.GetStockFundFlowRequest, callOptions ...callopt.Option) (r *stock.GetStockFundFlowResponse, err error)
+	GetIndexOverview(ctx context.Context, req *stock.GetIndexOverviewRequest, callOptions ...callopt.Option) (r *stock.GetIndexOverviewResponse, err error)
+	SearchSecurities(ctx context.Context, req *stock.SearchSecuritiesRequest, callOptions ...callopt.Option) (r *stock.SearchSecuritiesResponse, err error)
 	GetMarketSectors(ctx context.Context, req *stock.GetMarketSectorsRequest, callOptions ...callopt.Option) (r *stock.GetMarketSectorsResponse, err error)
+	GetSectorTimeline(ctx context.Context, req *stock.GetSectorTimelineRequest, callOptions ...callopt.Option) (r *stock.GetSectorTimelineResponse, err error)
+	GetSectorRotation(ctx context.Context, req *stock.GetSectorRotationRequest, callOptions ...callopt.Option) (r *stock.GetSectorRotationResponse, err error)
+	GetSectorFundFlow(ctx context.Context, req *stock.GetSectorFundFlowRequest, callOptions ...callopt.Option) (r *stock.GetSectorFundFlowResponse, err error)
 	GetLimitUpPool(ctx context.Context, req *stock.GetLimitUpPoolRequest, callOptions ...callopt.Option) (r *stock.GetLimitUpPoolResponse, err error)
+	GetLimitUpLadder(ctx context.Context, req *stock.GetLimitUpLadderRequest, callOptions ...callopt.Option) (r *stock.GetLimitUpLadderResponse, err error)
+	GetBrokenLimitPool(ctx context.Context, req *stock.GetBrokenLimitPoolRequest, callOptions ...callopt.Option) (r *stock.GetBrokenLimitPoolResponse, err error)
+	GetLimitDownPool(ctx context.Context, req *stock.GetLimitDownPoolRequest, callOptions ...callopt.Option) (r *stock.GetLimitDownPoolResponse, err error)
+	GetSentimentSnapshot(ctx context.Context, req *stock.GetSentimentSnapshotRequest, callOptions ...callopt.Option) (r *stock.GetSentimentSnapshotResponse, err error)
+	GetMarketBreadth(ctx context.Context, req *stock.GetMarketBreadthRequest, callOptions ...callopt.Option) (r *stock.GetMarketBreadthResponse, err error)
+	GetSentimentHistory(ctx context.Context, req *stock.GetSentimentHistoryRequest, callOptions ...callopt.Option) (r *stock.GetSentimentHistoryResponse, err error)
+	GetMarketTemperature(ctx context.Context, req *stock.GetMarketTemperatureRequest, callOptions ...callopt.Option) (r *stock.GetMarketTemperatureResponse, err error)
+	GetNorthboundFlow(ctx context.Context, req *stock.GetNorthboundFlowRequest, callOptions ...callopt.Option) (r *stock.GetNorthboundFlowResponse, err error)
+	GetNorthboundTopStocks(ctx context.Context, req *stock.GetNorthboundTopStocksRequest, callOptions ...callopt.Option) (r *stock.GetNorthboundTopStocksResponse, err error)
+	GetNorthboundHolding(ctx context.Context, req *stock.GetNorthboundHoldingRequest, callOptions ...callopt.Option) (r *stock.GetNorthboundHoldingResponse, err error)
+	GetMarginTrading(ctx context.Context, req *stock.GetMarginTradingRequest, callOptions ...callopt.Option) (r *stock.GetMarginTradingResponse, err error)
+	GetMarketMarginSummary(ctx context.Context, req *stock.GetMarketMarginSummaryRequest, callOptions ...callopt.Option) (r *stock.GetMarketMarginSummaryResponse, err error)
 	GetSectorStocks(ctx context.Context, req *stock.GetSectorStocksRequest, callOptions ...callopt.Option) (r *stock.GetSectorStocksResponse, err error)
 	GetDragonTigerList(ctx context.Context, req *stock.GetDragonTigerListRequest, callOptions ...callopt.Option) (r *stock.GetDragonTigerListResponse, err error)
 }
@@ -53,21 +80,156 @@ func (p *kStockServiceClient) GetRealtime(ctx context.Context, req *stock.GetRea
 	return p.kClient.GetRealtime(ctx, req)
 }
 
+func (p *kStockServiceClient) GetRealtimeBatch(ctx context.Context, req *stock.GetRealtimeBatchRequest, callOptions ...callopt.Option) (r *stock.GetRealtimeBatchResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRealtimeBatch(ctx, req)
+}
+
 func (p *kStockServiceClient) GetFinancialReport(ctx context.Context, req *stock.GetFinancialReportRequest, callOptions ...callopt.Option) (r *stock.GetFinancialReportResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetFinancialReport(ctx, req)
 }
 
+func (p *kStockServiceClient) GetFinancialStatements(ctx context.Context, req *stock.GetFinancialStatementsRequest, callOptions ...callopt.Option) (r *stock.GetFinancialStatementsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFinancialStatements(ctx, req)
+}
+
+func (p *kStockServiceClient) GetValuation(ctx context.Context, req *stock.GetValuationRequest, callOptions ...callopt.Option) (r *stock.GetValuationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetValuation(ctx, req)
+}
+
+func (p *kStockServiceClient) GetEarningsCalendar(ctx context.Context, req *stock.GetEarningsCalendarRequest, callOptions ...callopt.Option) (r *stock.GetEarningsCalendarResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetEarningsCalendar(ctx, req)
+}
+
+func (p *kStockServiceClient) GetShareUnlocks(ctx context.Context, req *stock.GetShareUnlocksRequest, callOptions ...callopt.Option) (r *stock.GetShareUnlocksResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetShareUnlocks(ctx, req)
+}
+
+func (p *kStockServiceClient) GetDividends(ctx context.Context, req *stock.GetDividendsRequest, callOptions ...callopt.Option) (r *stock.GetDividendsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDividends(ctx, req)
+}
+
+func (p *kStockServiceClient) GetCorporateActionCalendar(ctx context.Context, req *stock.GetCorporateActionCalendarRequest, callOptions ...callopt.Option) (r *stock.GetCorporateActionCalendarResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCorporateActionCalendar(ctx, req)
+}
+
+func (p *kStockServiceClient) GetKLine(ctx context.Context, req *stock.GetKLineRequest, callOptions ...callopt.Option) (r *stock.GetKLineResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetKLine(ctx, req)
+}
+
+func (p *kStockServiceClient) GetIntradayTrend(ctx context.Context, req *stock.GetIntradayTrendRequest, callOptions ...callopt.Option) (r *stock.GetIntradayTrendResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetIntradayTrend(ctx, req)
+}
+
+func (p *kStockServiceClient) GetStockFundFlow(ctx context.Context, req *stock.GetStockFundFlowRequest, callOptions ...callopt.Option) (r *stock.GetStockFundFlowResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetStockFundFlow(ctx, req)
+}
+
+func (p *kStockServiceClient) GetIndexOverview(ctx context.Context, req *stock.GetIndexOverviewRequest, callOptions ...callopt.Option) (r *stock.GetIndexOverviewResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetIndexOverview(ctx, req)
+}
+
+func (p *kStockServiceClient) SearchSecurities(ctx context.Context, req *stock.SearchSecuritiesRequest, callOptions ...callopt.Option) (r *stock.SearchSecuritiesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchSecurities(ctx, req)
+}
+
 func (p *kStockServiceClient) GetMarketSectors(ctx context.Context, req *stock.GetMarketSectorsRequest, callOptions ...callopt.Option) (r *stock.GetMarketSectorsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetMarketSectors(ctx, req)
 }
 
+func (p *kStockServiceClient) GetSectorTimeline(ctx context.Context, req *stock.GetSectorTimelineRequest, callOptions ...callopt.Option) (r *stock.GetSectorTimelineResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSectorTimeline(ctx, req)
+}
+
+func (p *kStockServiceClient) GetSectorRotation(ctx context.Context, req *stock.GetSectorRotationRequest, callOptions ...callopt.Option) (r *stock.GetSectorRotationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSectorRotation(ctx, req)
+}
+
+func (p *kStockServiceClient) GetSectorFundFlow(ctx context.Context, req *stock.GetSectorFundFlowRequest, callOptions ...callopt.Option) (r *stock.GetSectorFundFlowResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSectorFundFlow(ctx, req)
+}
+
 func (p *kStockServiceClient) GetLimitUpPool(ctx context.Context, req *stock.GetLimitUpPoolRequest, callOptions ...callopt.Option) (r *stock.GetLimitUpPoolResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetLimitUpPool(ctx, req)
 }
 
+func (p *kStockServiceClient) GetLimitUpLadder(ctx context.Context, req *stock.GetLimitUpLadderRequest, callOptions ...callopt.Option) (r *stock.GetLimitUpLadderResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetLimitUpLadder(ctx, req)
+}
+
+func (p *kStockServiceClient) GetBrokenLimitPool(ctx context.Context, req *stock.GetBrokenLimitPoolRequest, callOptions ...callopt.Option) (r *stock.GetBrokenLimitPoolResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBrokenLimitPool(ctx, req)
+}
+
+func (p *kStockServiceClient) GetLimitDownPool(ctx context.Context, req *stock.GetLimitDownPoolRequest, callOptions ...callopt.Option) (r *stock.GetLimitDownPoolResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetLimitDownPool(ctx, req)
+}
+
+func (p *kStockServiceClient) GetSentimentSnapshot(ctx context.Context, req *stock.GetSentimentSnapshotRequest, callOptions ...callopt.Option) (r *stock.GetSentimentSnapshotResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSentimentSnapshot(ctx, req)
+}
+
+func (p *kStockServiceClient) GetMarketBreadth(ctx context.Context, req *stock.GetMarketBreadthRequest, callOptions ...callopt.Option) (r *stock.GetMarketBreadthResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMarketBreadth(ctx, req)
+}
+
+func (p *kStockServiceClient) GetSentimentHistory(ctx context.Context, req *stock.GetSentimentHistoryRequest, callOptions ...callopt.Option) (r *stock.GetSentimentHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSentimentHistory(ctx, req)
+}
+
+func (p *kStockServiceClient) GetMarketTemperature(ctx context.Context, req *stock.GetMarketTemperatureRequest, callOptions ...callopt.Option) (r *stock.GetMarketTemperatureResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMarketTemperature(ctx, req)
+}
+
+func (p *kStockServiceClient) GetNorthboundFlow(ctx context.Context, req *stock.GetNorthboundFlowRequest, callOptions ...callopt.Option) (r *stock.GetNorthboundFlowResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNorthboundFlow(ctx, req)
+}
+
+func (p *kStockServiceClient) GetNorthboundTopStocks(ctx context.Context, req *stock.GetNorthboundTopStocksRequest, callOptions ...callopt.Option) (r *stock.GetNorthboundTopStocksResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNorthboundTopStocks(ctx, req)
+}
+
+func (p *kStockServiceClient) GetNorthboundHolding(ctx context.Context, req *stock.GetNorthboundHoldingRequest, callOptions ...callopt.Option) (r *stock.GetNorthboundHoldingResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNorthboundHolding(ctx, req)
+}
+
+func (p *kStockServiceClient) GetMarginTrading(ctx context.Context, req *stock.GetMarginTradingRequest, callOptions ...callopt.Option) (r *stock.GetMarginTradingResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMarginTrading(ctx, req)
+}
+
+func (p *kStockServiceClient) GetMarketMarginSummary(ctx context.Context, req *stock.GetMarketMarginSummaryRequest, callOptions ...callopt.Option) (r *stock.GetMarketMarginSummaryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMarketMarginSummary(ctx, req)
+}
+
 func (p *kStockServiceClient) GetSectorStocks(ctx context.Context, req *stock.GetSectorStocksRequest, callOptions ...callopt.Option) (r *stock.GetSectorStocksResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetSectorStocks(ctx, req)
@@ -1,11 +1,11 @@
-// Code generated by Kitex v1.20.0. DO NOT EDIT.
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
 
 package stockservice
 
 import (
-	client "github.com/cloudwego/kitex/client"
 	"context"
 	"errors"
+	client "github.com/cloudwego/kitex/client"
 	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
 	stock "stock_assistant/backend/gateway/kitex_gen/stock"
 )
@@ -20,6 +20,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetRealtimeBatch": kitex.NewMethodInfo(
+		getRealtimeBatchHandler,
+		newStockServiceGetRealtimeBatchArgs,
+		newStockServiceGetRealtimeBatchResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetFinancialReport": kitex.NewMethodInfo(
 		getFinancialReportHandler,
 		newStockServiceGetFinancialReportArgs,
@@ -27,6 +34,83 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetFinancialStatements": kitex.NewMethodInfo(
+		getFinancialStatementsHandler,
+		newStockServiceGetFinancialStatementsArgs,
+		newStockServiceGetFinancialStatementsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetValuation": kitex.NewMethodInfo(
+		getValuationHandler,
+		newStockServiceGetValuationArgs,
+		newStockServiceGetValuationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetEarningsCalendar": kitex.NewMethodInfo(
+		getEarningsCalendarHandler,
+		newStockServiceGetEarningsCalendarArgs,
+		newStockServiceGetEarningsCalendarResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetShareUnlocks": kitex.NewMethodInfo(
+		getShareUnlocksHandler,
+		newStockServiceGetShareUnlocksArgs,
+		newStockServiceGetShareUnlocksResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDividends": kitex.NewMethodInfo(
+		getDividendsHandler,
+		newStockServiceGetDividendsArgs,
+		newStockServiceGetDividendsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCorporateActionCalendar": kitex.NewMethodInfo(
+		getCorporateActionCalendarHandler,
+		newStockServiceGetCorporateActionCalendarArgs,
+		newStockServiceGetCorporateActionCalendarResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetKLine": kitex.NewMethodInfo(
+		getKLineHandler,
+		newStockServiceGetKLineArgs,
+		newStockServiceGetKLineResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetIntradayTrend": kitex.NewMethodInfo(
+		getIntradayTrendHandler,
+		newStockServiceGetIntradayTrendArgs,
+		newStockServiceGetIntradayTrendResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetStockFundFlow": kitex.NewMethodInfo(
+		getStockFundFlowHandler,
+		newStockServiceGetStockFundFlowArgs,
+		newStockServiceGetStockFundFlowResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetIndexOverview": kitex.NewMethodInfo(
+		getIndexOverviewHandler,
+		newStockServiceGetIndexOverviewArgs,
+		newStockServiceGetIndexOverviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SearchSecurities": kitex.NewMethodInfo(
+		searchSecuritiesHandler,
+		newStockServiceSearchSecuritiesArgs,
+		newStockServiceSearchSecuritiesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetMarketSectors": kitex.NewMethodInfo(
 		getMarketSectorsHandler,
 		newStockServiceGetMarketSectorsArgs,
@@ -34,6 +118,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetSectorTimeline": kitex.NewMethodInfo(
+		getSectorTimelineHandler,
+		newStockServiceGetSectorTimelineArgs,
+		newStockServiceGetSectorTimelineResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSectorRotation": kitex.NewMethodInfo(
+		getSectorRotationHandler,
+		newStockServiceGetSectorRotationArgs,
+		newStockServiceGetSectorRotationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSectorFundFlow": kitex.NewMethodInfo(
+		getSectorFundFlowHandler,
+		newStockServiceGetSectorFundFlowArgs,
+		newStockServiceGetSectorFundFlowResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetLimitUpPool": kitex.NewMethodInfo(
 		getLimitUpPoolHandler,
 		newStockServiceGetLimitUpPoolArgs,
@@ -41,6 +146,90 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetLimitUpLadder": kitex.NewMethodInfo(
+		getLimitUpLadderHandler,
+		newStockServiceGetLimitUpLadderArgs,
+		newStockServiceGetLimitUpLadderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetBrokenLimitPool": kitex.NewMethodInfo(
+		getBrokenLimitPoolHandler,
+		newStockServiceGetBrokenLimitPoolArgs,
+		newStockServiceGetBrokenLimitPoolResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetLimitDownPool": kitex.NewMethodInfo(
+		getLimitDownPoolHandler,
+		newStockServiceGetLimitDownPoolArgs,
+		newStockServiceGetLimitDownPoolResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSentimentSnapshot": kitex.NewMethodInfo(
+		getSentimentSnapshotHandler,
+		newStockServiceGetSentimentSnapshotArgs,
+		newStockServiceGetSentimentSnapshotResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMarketBreadth": kitex.NewMethodInfo(
+		getMarketBreadthHandler,
+		newStockServiceGetMarketBreadthArgs,
+		newStockServiceGetMarketBreadthResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSentimentHistory": kitex.NewMethodInfo(
+		getSentimentHistoryHandler,
+		newStockServiceGetSentimentHistoryArgs,
+		newStockServiceGetSentimentHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMarketTemperature": kitex.NewMethodInfo(
+		getMarketTemperatureHandler,
+		newStockServiceGetMarketTemperatureArgs,
+		newStockServiceGetMarketTemperatureResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNorthboundFlow": kitex.NewMethodInfo(
+		getNorthboundFlowHandler,
+		newStockServiceGetNorthboundFlowArgs,
+		newStockServiceGetNorthboundFlowResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNorthboundTopStocks": kitex.NewMethodInfo(
+		getNorthboundTopStocksHandler,
+		newStockServiceGetNorthboundTopStocksArgs,
+		newStockServiceGetNorthboundTopStocksResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNorthboundHolding": kitex.NewMethodInfo(
+		getNorthboundHoldingHandler,
+		newStockServiceGetNorthboundHoldingArgs,
+		newStockServiceGetNorthboundHoldingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMarginTrading": kitex.NewMethodInfo(
+		getMarginTradingHandler,
+		newStockServiceGetMarginTradingArgs,
+		newStockServiceGetMarginTradingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMarketMarginSummary": kitex.NewMethodInfo(
+		getMarketMarginSummaryHandler,
+		newStockServiceGetMarketMarginSummaryArgs,
+		newStockServiceGetMarketMarginSummaryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetSectorStocks": kitex.NewMethodInfo(
 		getSectorStocksHandler,
 		newStockServiceGetSectorStocksArgs,
@@ -115,7 +304,7 @@ func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreami
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v1.20.0",
+		KiteXGenVersion: "v0.15.4",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -139,6 +328,24 @@ func newStockServiceGetRealtimeResult() interface{} {
 	return stock.NewStockServiceGetRealtimeResult()
 }
 
+func getRealtimeBatchHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetRealtimeBatchArgs)
+	realResult := result.(*stock.StockServiceGetRealtimeBatchResult)
+	success, err := handler.(stock.StockService).GetRealtimeBatch(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetRealtimeBatchArgs() interface{} {
+	return stock.NewStockServiceGetRealtimeBatchArgs()
+}
+
+func newStockServiceGetRealtimeBatchResult() interface{} {
+	return stock.NewStockServiceGetRealtimeBatchResult()
+}
+
 func getFinancialReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*stock.StockServiceGetFinancialReportArgs)
 	realResult := result.(*stock.StockServiceGetFinancialReportResult)
@@ -157,6 +364,204 @@ func newStockServiceGetFinancialReportResult() interface{} {
 	return stock.NewStockServiceGetFinancialReportResult()
 }
 
+func getFinancialStatementsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetFinancialStatementsArgs)
+	realResult := result.(*stock.StockServiceGetFinancialStatementsResult)
+	success, err := handler.(stock.StockService).GetFinancialStatements(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetFinancialStatementsArgs() interface{} {
+	return stock.NewStockServiceGetFinancialStatementsArgs()
+}
+
+func newStockServiceGetFinancialStatementsResult() interface{} {
+	return stock.NewStockServiceGetFinancialStatementsResult()
+}
+
+func getValuationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetValuationArgs)
+	realResult := result.(*stock.StockServiceGetValuationResult)
+	success, err := handler.(stock.StockService).GetValuation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetValuationArgs() interface{} {
+	return stock.NewStockServiceGetValuationArgs()
+}
+
+func newStockServiceGetValuationResult() interface{} {
+	return stock.NewStockServiceGetValuationResult()
+}
+
+func getEarningsCalendarHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetEarningsCalendarArgs)
+	realResult := result.(*stock.StockServiceGetEarningsCalendarResult)
+	success, err := handler.(stock.StockService).GetEarningsCalendar(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetEarningsCalendarArgs() interface{} {
+	return stock.NewStockServiceGetEarningsCalendarArgs()
+}
+
+func newStockServiceGetEarningsCalendarResult() interface{} {
+	return stock.NewStockServiceGetEarningsCalendarResult()
+}
+
+func getShareUnlocksHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetShareUnlocksArgs)
+	realResult := result.(*stock.StockServiceGetShareUnlocksResult)
+	success, err := handler.(stock.StockService).GetShareUnlocks(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetShareUnlocksArgs() interface{} {
+	return stock.NewStockServiceGetShareUnlocksArgs()
+}
+
+func newStockServiceGetShareUnlocksResult() interface{} {
+	return stock.NewStockServiceGetShareUnlocksResult()
+}
+
+func getDividendsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetDividendsArgs)
+	realResult := result.(*stock.StockServiceGetDividendsResult)
+	success, err := handler.(stock.StockService).GetDividends(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetDividendsArgs() interface{} {
+	return stock.NewStockServiceGetDividendsArgs()
+}
+
+func newStockServiceGetDividendsResult() interface{} {
+	return stock.NewStockServiceGetDividendsResult()
+}
+
+func getCorporateActionCalendarHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetCorporateActionCalendarArgs)
+	realResult := result.(*stock.StockServiceGetCorporateActionCalendarResult)
+	success, err := handler.(stock.StockService).GetCorporateActionCalendar(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetCorporateActionCalendarArgs() interface{} {
+	return stock.NewStockServiceGetCorporateActionCalendarArgs()
+}
+
+func newStockServiceGetCorporateActionCalendarResult() interface{} {
+	return stock.NewStockServiceGetCorporateActionCalendarResult()
+}
+
+func getKLineHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetKLineArgs)
+	realResult := result.(*stock.StockServiceGetKLineResult)
+	success, err := handler.(stock.StockService).GetKLine(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetKLineArgs() interface{} {
+	return stock.NewStockServiceGetKLineArgs()
+}
+
+func newStockServiceGetKLineResult() interface{} {
+	return stock.NewStockServiceGetKLineResult()
+}
+
+func getIntradayTrendHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetIntradayTrendArgs)
+	realResult := result.(*stock.StockServiceGetIntradayTrendResult)
+	success, err := handler.(stock.StockService).GetIntradayTrend(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetIntradayTrendArgs() interface{} {
+	return stock.NewStockServiceGetIntradayTrendArgs()
+}
+
+func newStockServiceGetIntradayTrendResult() interface{} {
+	return stock.NewStockServiceGetIntradayTrendResult()
+}
+
+func getStockFundFlowHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetStockFundFlowArgs)
+	realResult := result.(*stock.StockServiceGetStockFundFlowResult)
+	success, err := handler.(stock.StockService).GetStockFundFlow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetStockFundFlowArgs() interface{} {
+	return stock.NewStockServiceGetStockFundFlowArgs()
+}
+
+func newStockServiceGetStockFundFlowResult() interface{} {
+	return stock.NewStockServiceGetStockFundFlowResult()
+}
+
+func getIndexOverviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetIndexOverviewArgs)
+	realResult := result.(*stock.StockServiceGetIndexOverviewResult)
+	success, err := handler.(stock.StockService).GetIndexOverview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetIndexOverviewArgs() interface{} {
+	return stock.NewStockServiceGetIndexOverviewArgs()
+}
+
+func newStockServiceGetIndexOverviewResult() interface{} {
+	return stock.NewStockServiceGetIndexOverviewResult()
+}
+
+func searchSecuritiesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceSearchSecuritiesArgs)
+	realResult := result.(*stock.StockServiceSearchSecuritiesResult)
+	success, err := handler.(stock.StockService).SearchSecurities(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceSearchSecuritiesArgs() interface{} {
+	return stock.NewStockServiceSearchSecuritiesArgs()
+}
+
+func newStockServiceSearchSecuritiesResult() interface{} {
+	return stock.NewStockServiceSearchSecuritiesResult()
+}
+
 func getMarketSectorsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*stock.StockServiceGetMarketSectorsArgs)
 	realResult := result.(*stock.StockServiceGetMarketSectorsResult)
@@ -175,6 +580,60 @@ func newStockServiceGetMarketSectorsResult() interface{} {
 	return stock.NewStockServiceGetMarketSectorsResult()
 }
 
+func getSectorTimelineHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetSectorTimelineArgs)
+	realResult := result.(*stock.StockServiceGetSectorTimelineResult)
+	success, err := handler.(stock.StockService).GetSectorTimeline(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetSectorTimelineArgs() interface{} {
+	return stock.NewStockServiceGetSectorTimelineArgs()
+}
+
+func newStockServiceGetSectorTimelineResult() interface{} {
+	return stock.NewStockServiceGetSectorTimelineResult()
+}
+
+func getSectorRotationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetSectorRotationArgs)
+	realResult := result.(*stock.StockServiceGetSectorRotationResult)
+	success, err := handler.(stock.StockService).GetSectorRotation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetSectorRotationArgs() interface{} {
+	return stock.NewStockServiceGetSectorRotationArgs()
+}
+
+func newStockServiceGetSectorRotationResult() interface{} {
+	return stock.NewStockServiceGetSectorRotationResult()
+}
+
+func getSectorFundFlowHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetSectorFundFlowArgs)
+	realResult := result.(*stock.StockServiceGetSectorFundFlowResult)
+	success, err := handler.(stock.StockService).GetSectorFundFlow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetSectorFundFlowArgs() interface{} {
+	return stock.NewStockServiceGetSectorFundFlowArgs()
+}
+
+func newStockServiceGetSectorFundFlowResult() interface{} {
+	return stock.NewStockServiceGetSectorFundFlowResult()
+}
+
 func getLimitUpPoolHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*stock.StockServiceGetLimitUpPoolArgs)
 	realResult := result.(*stock.StockServiceGetLimitUpPoolResult)
@@ -193,44 +652,260 @@ func newStockServiceGetLimitUpPoolResult() interface{} {
 	return stock.NewStockServiceGetLimitUpPoolResult()
 }
 
-func getSectorStocksHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*stock.StockServiceGetSectorStocksArgs)
-	realResult := result.(*stock.StockServiceGetSectorStocksResult)
-	success, err := handler.(stock.StockService).GetSectorStocks(ctx, realArg.Req)
+func getLimitUpLadderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetLimitUpLadderArgs)
+	realResult := result.(*stock.StockServiceGetLimitUpLadderResult)
+	success, err := handler.(stock.StockService).GetLimitUpLadder(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newStockServiceGetSectorStocksArgs() interface{} {
-	return stock.NewStockServiceGetSectorStocksArgs()
+func newStockServiceGetLimitUpLadderArgs() interface{} {
+	return stock.NewStockServiceGetLimitUpLadderArgs()
 }
 
-func newStockServiceGetSectorStocksResult() interface{} {
-	return stock.NewStockServiceGetSectorStocksResult()
+func newStockServiceGetLimitUpLadderResult() interface{} {
+	return stock.NewStockServiceGetLimitUpLadderResult()
 }
 
-func getDragonTigerListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*stock.StockServiceGetDragonTigerListArgs)
-	realResult := result.(*stock.StockServiceGetDragonTigerListResult)
-	success, err := handler.(stock.StockService).GetDragonTigerList(ctx, realArg.Req)
+func getBrokenLimitPoolHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetBrokenLimitPoolArgs)
+	realResult := result.(*stock.StockServiceGetBrokenLimitPoolResult)
+	success, err := handler.(stock.StockService).GetBrokenLimitPool(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newStockServiceGetDragonTigerListArgs() interface{} {
-	return stock.NewStockServiceGetDragonTigerListArgs()
+func newStockServiceGetBrokenLimitPoolArgs() interface{} {
+	return stock.NewStockServiceGetBrokenLimitPoolArgs()
 }
 
-func newStockServiceGetDragonTigerListResult() interface{} {
-	return stock.NewStockServiceGetDragonTigerListResult()
+func newStockServiceGetBrokenLimitPoolResult() interface{} {
+	return stock.NewStockServiceGetBrokenLimitPoolResult()
 }
 
-type kClient struct {
-	c client.Client
+func getLimitDownPoolHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetLimitDownPoolArgs)
+	realResult := result.(*stock.StockServiceGetLimitDownPoolResult)
+	success, err := handler.(stock.StockService).GetLimitDownPool(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetLimitDownPoolArgs() interface{} {
+	return stock.NewStockServiceGetLimitDownPoolArgs()
+}
+
+func newStockServiceGetLimitDownPoolResult() interface{} {
+	return stock.NewStockServiceGetLimitDownPoolResult()
+}
+
+func getSentimentSnapshotHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetSentimentSnapshotArgs)
+	realResult := result.(*stock.StockServiceGetSentimentSnapshotResult)
+	success, err := handler.(stock.StockService).GetSentimentSnapshot(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetSentimentSnapshotArgs() interface{} {
+	return stock.NewStockServiceGetSentimentSnapshotArgs()
+}
+
+func newStockServiceGetSentimentSnapshotResult() interface{} {
+	return stock.NewStockServiceGetSentimentSnapshotResult()
+}
+
+func getMarketBreadthHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetMarketBreadthArgs)
+	realResult := result.(*stock.StockServiceGetMarketBreadthResult)
+	success, err := handler.(stock.StockService).GetMarketBreadth(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetMarketBreadthArgs() interface{} {
+	return stock.NewStockServiceGetMarketBreadthArgs()
+}
+
+func newStockServiceGetMarketBreadthResult() interface{} {
+	return stock.NewStockServiceGetMarketBreadthResult()
+}
+
+func getSentimentHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetSentimentHistoryArgs)
+	realResult := result.(*stock.StockServiceGetSentimentHistoryResult)
+	success, err := handler.(stock.StockService).GetSentimentHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetSentimentHistoryArgs() interface{} {
+	return stock.NewStockServiceGetSentimentHistoryArgs()
+}
+
+func newStockServiceGetSentimentHistoryResult() interface{} {
+	return stock.NewStockServiceGetSentimentHistoryResult()
+}
+
+func getMarketTemperatureHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetMarketTemperatureArgs)
+	realResult := result.(*stock.StockServiceGetMarketTemperatureResult)
+	success, err := handler.(stock.StockService).GetMarketTemperature(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetMarketTemperatureArgs() interface{} {
+	return stock.NewStockServiceGetMarketTemperatureArgs()
+}
+
+func newStockServiceGetMarketTemperatureResult() interface{} {
+	return stock.NewStockServiceGetMarketTemperatureResult()
+}
+
+func getNorthboundFlowHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetNorthboundFlowArgs)
+	realResult := result.(*stock.StockServiceGetNorthboundFlowResult)
+	success, err := handler.(stock.StockService).GetNorthboundFlow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetNorthboundFlowArgs() interface{} {
+	return stock.NewStockServiceGetNorthboundFlowArgs()
+}
+
+func newStockServiceGetNorthboundFlowResult() interface{} {
+	return stock.NewStockServiceGetNorthboundFlowResult()
+}
+
+func getNorthboundTopStocksHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetNorthboundTopStocksArgs)
+	realResult := result.(*stock.StockServiceGetNorthboundTopStocksResult)
+	success, err := handler.(stock.StockService).GetNorthboundTopStocks(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetNorthboundTopStocksArgs() interface{} {
+	return stock.NewStockServiceGetNorthboundTopStocksArgs()
+}
+
+func newStockServiceGetNorthboundTopStocksResult() interface{} {
+	return stock.NewStockServiceGetNorthboundTopStocksResult()
+}
+
+func getNorthboundHoldingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetNorthboundHoldingArgs)
+	realResult := result.(*stock.StockServiceGetNorthboundHoldingResult)
+	success, err := handler.(stock.StockService).GetNorthboundHolding(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetNorthboundHoldingArgs() interface{} {
+	return stock.NewStockServiceGetNorthboundHoldingArgs()
+}
+
+func newStockServiceGetNorthboundHoldingResult() interface{} {
+	return stock.NewStockServiceGetNorthboundHoldingResult()
+}
+
+func getMarginTradingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetMarginTradingArgs)
+	realResult := result.(*stock.StockServiceGetMarginTradingResult)
+	success, err := handler.(stock.StockService).GetMarginTrading(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetMarginTradingArgs() interface{} {
+	return stock.NewStockServiceGetMarginTradingArgs()
+}
+
+func newStockServiceGetMarginTradingResult() interface{} {
+	return stock.NewStockServiceGetMarginTradingResult()
+}
+
+func getMarketMarginSummaryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetMarketMarginSummaryArgs)
+	realResult := result.(*stock.StockServiceGetMarketMarginSummaryResult)
+	success, err := handler.(stock.StockService).GetMarketMarginSummary(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetMarketMarginSummaryArgs() interface{} {
+	return stock.NewStockServiceGetMarketMarginSummaryArgs()
+}
+
+func newStockServiceGetMarketMarginSummaryResult() interface{} {
+	return stock.NewStockServiceGetMarketMarginSummaryResult()
+}
+
+func getSectorStocksHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetSectorStocksArgs)
+	realResult := result.(*stock.StockServiceGetSectorStocksResult)
+	success, err := handler.(stock.StockService).GetSectorStocks(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetSectorStocksArgs() interface{} {
+	return stock.NewStockServiceGetSectorStocksArgs()
+}
+
+func newStockServiceGetSectorStocksResult() interface{} {
+	return stock.NewStockServiceGetSectorStocksResult()
+}
+
+func getDragonTigerListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*stock.StockServiceGetDragonTigerListArgs)
+	realResult := result.(*stock.StockServiceGetDragonTigerListResult)
+	success, err := handler.(stock.StockService).GetDragonTigerList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newStockServiceGetDragonTigerListArgs() interface{} {
+	return stock.NewStockServiceGetDragonTigerListArgs()
+}
+
+func newStockServiceGetDragonTigerListResult() interface{} {
+	return stock.NewStockServiceGetDragonTigerListResult()
+}
+
+type kClient struct {
+	c client.Client
 }
 
 func newServiceClient(c client.Client) *kClient {
@@ -249,6 +924,16 @@ func (p *kClient) GetRealtime(ctx context.Context, req *stock.GetRealtimeRequest
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetRealtimeBatch(ctx context.Context, req *stock.GetRealtimeBatchRequest) (r *stock.GetRealtimeBatchResponse, err error) {
+	var _args stock.StockServiceGetRealtimeBatchArgs
+	_args.Req = req
+	var _result stock.StockServiceGetRealtimeBatchResult
+	if err = p.c.Call(ctx, "GetRealtimeBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetFinancialReport(ctx context.Context, req *stock.GetFinancialReportRequest) (r *stock.GetFinancialReportResponse, err error) {
 	var _args stock.StockServiceGetFinancialReportArgs
 	_args.Req = req
@@ -259,6 +944,116 @@ func (p *kClient) GetFinancialReport(ctx context.Context, req *stock.GetFinancia
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetFinancialStatements(ctx context.Context, req *stock.GetFinancialStatementsRequest) (r *stock.GetFinancialStatementsResponse, err error) {
+	var _args stock.StockServiceGetFinancialStatementsArgs
+	_args.Req = req
+	var _result stock.StockServiceGetFinancialStatementsResult
+	if err = p.c.Call(ctx, "GetFinancialStatements", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetValuation(ctx context.Context, req *stock.GetValuationRequest) (r *stock.GetValuationResponse, err error) {
+	var _args stock.StockServiceGetValuationArgs
+	_args.Req = req
+	var _result stock.StockServiceGetValuationResult
+	if err = p.c.Call(ctx, "GetValuation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetEarningsCalendar(ctx context.Context, req *stock.GetEarningsCalendarRequest) (r *stock.GetEarningsCalendarResponse, err error) {
+	var _args stock.StockServiceGetEarningsCalendarArgs
+	_args.Req = req
+	var _result stock.StockServiceGetEarningsCalendarResult
+	if err = p.c.Call(ctx, "GetEarningsCalendar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetShareUnlocks(ctx context.Context, req *stock.GetShareUnlocksRequest) (r *stock.GetShareUnlocksResponse, err error) {
+	var _args stock.StockServiceGetShareUnlocksArgs
+	_args.Req = req
+	var _result stock.StockServiceGetShareUnlocksResult
+	if err = p.c.Call(ctx, "GetShareUnlocks", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDividends(ctx context.Context, req *stock.GetDividendsRequest) (r *stock.GetDividendsResponse, err error) {
+	var _args stock.StockServiceGetDividendsArgs
+	_args.Req = req
+	var _result stock.StockServiceGetDividendsResult
+	if err = p.c.Call(ctx, "GetDividends", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCorporateActionCalendar(ctx context.Context, req *stock.GetCorporateActionCalendarRequest) (r *stock.GetCorporateActionCalendarResponse, err error) {
+	var _args stock.StockServiceGetCorporateActionCalendarArgs
+	_args.Req = req
+	var _result stock.StockServiceGetCorporateActionCalendarResult
+	if err = p.c.Call(ctx, "GetCorporateActionCalendar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetKLine(ctx context.Context, req *stock.GetKLineRequest) (r *stock.GetKLineResponse, err error) {
+	var _args stock.StockServiceGetKLineArgs
+	_args.Req = req
+	var _result stock.StockServiceGetKLineResult
+	if err = p.c.Call(ctx, "GetKLine", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetIntradayTrend(ctx context.Context, req *stock.GetIntradayTrendRequest) (r *stock.GetIntradayTrendResponse, err error) {
+	var _args stock.StockServiceGetIntradayTrendArgs
+	_args.Req = req
+	var _result stock.StockServiceGetIntradayTrendResult
+	if err = p.c.Call(ctx, "GetIntradayTrend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetStockFundFlow(ctx context.Context, req *stock.GetStockFundFlowRequest) (r *stock.GetStockFundFlowResponse, err error) {
+	var _args stock.StockServiceGetStockFundFlowArgs
+	_args.Req = req
+	var _result stock.StockServiceGetStockFundFlowResult
+	if err = p.c.Call(ctx, "GetStockFundFlow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetIndexOverview(ctx context.Context, req *stock.GetIndexOverviewRequest) (r *stock.GetIndexOverviewResponse, err error) {
+	var _args stock.StockServiceGetIndexOverviewArgs
+	_args.Req = req
+	var _result stock.StockServiceGetIndexOverviewResult
+	if err = p.c.Call(ctx, "GetIndexOverview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchSecurities(ctx context.Context, req *stock.SearchSecuritiesRequest) (r *stock.SearchSecuritiesResponse, err error) {
+	var _args stock.StockServiceSearchSecuritiesArgs
+	_args.Req = req
+	var _result stock.StockServiceSearchSecuritiesResult
+	if err = p.c.Call(ctx, "SearchSecurities", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetMarketSectors(ctx context.Context, req *stock.GetMarketSectorsRequest) (r *stock.GetMarketSectorsResponse, err error) {
 	var _args stock.StockServiceGetMarketSectorsArgs
 	_args.Req = req
@@ -269,6 +1064,36 @@ func (p *kClient) GetMarketSectors(ctx context.Context, req *stock.GetMarketSect
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetSectorTimeline(ctx context.Context, req *stock.GetSectorTimelineRequest) (r *stock.GetSectorTimelineResponse, err error) {
+	var _args stock.StockServiceGetSectorTimelineArgs
+	_args.Req = req
+	var _result stock.StockServiceGetSectorTimelineResult
+	if err = p.c.Call(ctx, "GetSectorTimeline", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSectorRotation(ctx context.Context, req *stock.GetSectorRotationRequest) (r *stock.GetSectorRotationResponse, err error) {
+	var _args stock.StockServiceGetSectorRotationArgs
+	_args.Req = req
+	var _result stock.StockServiceGetSectorRotationResult
+	if err = p.c.Call(ctx, "GetSectorRotation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSectorFundFlow(ctx context.Context, req *stock.GetSectorFundFlowRequest) (r *stock.GetSectorFundFlowResponse, err error) {
+	var _args stock.StockServiceGetSectorFundFlowArgs
+	_args.Req = req
+	var _result stock.StockServiceGetSectorFundFlowResult
+	if err = p.c.Call(ctx, "GetSectorFundFlow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetLimitUpPool(ctx context.Context, req *stock.GetLimitUpPoolRequest) (r *stock.GetLimitUpPoolResponse, err error) {
 	var _args stock.StockServiceGetLimitUpPoolArgs
 	_args.Req = req
@@ -279,6 +1104,126 @@ func (p *kClient) GetLimitUpPool(ctx context.Context, req *stock.GetLimitUpPoolR
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetLimitUpLadder(ctx context.Context, req *stock.GetLimitUpLadderRequest) (r *stock.GetLimitUpLadderResponse, err error) {
+	var _args stock.StockServiceGetLimitUpLadderArgs
+	_args.Req = req
+	var _result stock.StockServiceGetLimitUpLadderResult
+	if err = p.c.Call(ctx, "GetLimitUpLadder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBrokenLimitPool(ctx context.Context, req *stock.GetBrokenLimitPoolRequest) (r *stock.GetBrokenLimitPoolResponse, err error) {
+	var _args stock.StockServiceGetBrokenLimitPoolArgs
+	_args.Req = req
+	var _result stock.StockServiceGetBrokenLimitPoolResult
+	if err = p.c.Call(ctx, "GetBrokenLimitPool", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetLimitDownPool(ctx context.Context, req *stock.GetLimitDownPoolRequest) (r *stock.GetLimitDownPoolResponse, err error) {
+	var _args stock.StockServiceGetLimitDownPoolArgs
+	_args.Req = req
+	var _result stock.StockServiceGetLimitDownPoolResult
+	if err = p.c.Call(ctx, "GetLimitDownPool", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSentimentSnapshot(ctx context.Context, req *stock.GetSentimentSnapshotRequest) (r *stock.GetSentimentSnapshotResponse, err error) {
+	var _args stock.StockServiceGetSentimentSnapshotArgs
+	_args.Req = req
+	var _result stock.StockServiceGetSentimentSnapshotResult
+	if err = p.c.Call(ctx, "GetSentimentSnapshot", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMarketBreadth(ctx context.Context, req *stock.GetMarketBreadthRequest) (r *stock.GetMarketBreadthResponse, err error) {
+	var _args stock.StockServiceGetMarketBreadthArgs
+	_args.Req = req
+	var _result stock.StockServiceGetMarketBreadthResult
+	if err = p.c.Call(ctx, "GetMarketBreadth", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSentimentHistory(ctx context.Context, req *stock.GetSentimentHistoryRequest) (r *stock.GetSentimentHistoryResponse, err error) {
+	var _args stock.StockServiceGetSentimentHistoryArgs
+	_args.Req = req
+	var _result stock.StockServiceGetSentimentHistoryResult
+	if err = p.c.Call(ctx, "GetSentimentHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMarketTemperature(ctx context.Context, req *stock.GetMarketTemperatureRequest) (r *stock.GetMarketTemperatureResponse, err error) {
+	var _args stock.StockServiceGetMarketTemperatureArgs
+	_args.Req = req
+	var _result stock.StockServiceGetMarketTemperatureResult
+	if err = p.c.Call(ctx, "GetMarketTemperature", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNorthboundFlow(ctx context.Context, req *stock.GetNorthboundFlowRequest) (r *stock.GetNorthboundFlowResponse, err error) {
+	var _args stock.StockServiceGetNorthboundFlowArgs
+	_args.Req = req
+	var _result stock.StockServiceGetNorthboundFlowResult
+	if err = p.c.Call(ctx, "GetNorthboundFlow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNorthboundTopStocks(ctx context.Context, req *stock.GetNorthboundTopStocksRequest) (r *stock.GetNorthboundTopStocksResponse, err error) {
+	var _args stock.StockServiceGetNorthboundTopStocksArgs
+	_args.Req = req
+	var _result stock.StockServiceGetNorthboundTopStocksResult
+	if err = p.c.Call(ctx, "GetNorthboundTopStocks", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNorthboundHolding(ctx context.Context, req *stock.GetNorthboundHoldingRequest) (r *stock.GetNorthboundHoldingResponse, err error) {
+	var _args stock.StockServiceGetNorthboundHoldingArgs
+	_args.Req = req
+	var _result stock.StockServiceGetNorthboundHoldingResult
+	if err = p.c.Call(ctx, "GetNorthboundHolding", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMarginTrading(ctx context.Context, req *stock.GetMarginTradingRequest) (r *stock.GetMarginTradingResponse, err error) {
+	var _args stock.StockServiceGetMarginTradingArgs
+	_args.Req = req
+	var _result stock.StockServiceGetMarginTradingResult
+	if err = p.c.Call(ctx, "GetMarginTrading", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMarketMarginSummary(ctx context.Context, req *stock.GetMarketMarginSummaryRequest) (r *stock.GetMarketMarginSummaryResponse, err error) {
+	var _args stock.StockServiceGetMarketMarginSummaryArgs
+	_args.Req = req
+	var _result stock.StockServiceGetMarketMarginSummaryResult
+	if err = p.c.Call(ctx, "GetMarketMarginSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetSectorStocks(ctx context.Context, req *stock.GetSectorStocksRequest) (r *stock.GetSectorStocksResponse, err error) {
 	var _args stock.StockServiceGetSectorStocksArgs
 	_args.Req = req
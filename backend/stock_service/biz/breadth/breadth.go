@@ -0,0 +1,183 @@
+// Package breadth computes the advance/decline statistics of the whole A-share market.
+package breadth
+
+import (
+	"encoding/json"
+	"strings"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+	"stock_assistant/backend/stock_service/dal/model"
+)
+
+// Bucket labels of the change distribution, from the strongest to the weakest.
+// Ranges include their lower bound in absolute value, e.g. 3~5 is [3%, 5%).
+// Stocks at their limit price only count in limit_up or limit_down.
+var BucketLabels = []string{"limit_up", ">=7", "5~7", "3~5", "0~3", "flat", "-3~0", "-5~-3", "-7~-5", "<=-7", "limit_down"}
+
+// Boards are the board breakdowns, in display order
+var Boards = []symbol.Board{symbol.BoardMain, symbol.BoardChiNext, symbol.BoardSTAR, symbol.BoardBSE}
+
+// Stats are the counts of one group of stocks
+type Stats struct {
+	Advance   int     `json:"advance"`
+	Decline   int     `json:"decline"`
+	Flat      int     `json:"flat"`
+	LimitUp   int     `json:"limit_up"` // Closed at the limit-up price
+	LimitDown int     `json:"limit_down"`
+	Amount    float64 `json:"amount"` // Turnover (CNY)
+}
+
+// Breadth is the market-wide picture at one moment
+type Breadth struct {
+	Stats
+	Suspended int      // Not traded, left out of every count
+	Buckets   []int    // Counts in BucketLabels order
+	Boards    []*Stats // Breakdowns in Boards order
+}
+
+// Compute builds the breadth from the quotes of every listed stock
+func Compute(quotes []*eastmoney.MarketQuote) *Breadth {
+	b := &Breadth{
+		Buckets: make([]int, len(BucketLabels)),
+		Boards:  make([]*Stats, len(Boards)),
+	}
+	boardIndex := make(map[symbol.Board]int, len(Boards))
+	for i, board := range Boards {
+		b.Boards[i] = &Stats{}
+		boardIndex[board] = i
+	}
+
+	for _, q := range quotes {
+		if q.Price <= 0 || q.PrevClose <= 0 {
+			b.Suspended++
+			continue
+		}
+		sec, err := symbol.Parse(q.Symbol + "." + q.Exchange)
+		if err != nil {
+			continue
+		}
+
+		bucket := bucketOf(sec, q)
+		b.Buckets[bucket]++
+		b.add(bucket, q.Amount)
+		if i, ok := boardIndex[sec.Board]; ok {
+			b.Boards[i].add(bucket, q.Amount)
+		}
+	}
+	return b
+}
+
+// Indexes of the special buckets in BucketLabels
+const (
+	limitUpBucket   = 0
+	flatBucket      = 5
+	limitDownBucket = 10
+)
+
+func (s *Stats) add(bucket int, amount float64) {
+	switch {
+	case bucket < flatBucket:
+		s.Advance++
+	case bucket == flatBucket:
+		s.Flat++
+	default:
+		s.Decline++
+	}
+	switch bucket {
+	case limitUpBucket:
+		s.LimitUp++
+	case limitDownBucket:
+		s.LimitDown++
+	}
+	s.Amount += amount
+}
+
+// bucketOf returns the BucketLabels index of a traded stock
+func bucketOf(sec symbol.Security, q *eastmoney.MarketQuote) int {
+	if !noPriceLimit(q.Name) {
+		up, down := sec.LimitPrices(q.PrevClose, q.Name)
+		if up > 0 && q.Price >= up {
+			return limitUpBucket
+		}
+		if down > 0 && q.Price <= down {
+			return limitDownBucket
+		}
+	}
+
+	c := q.ChangePercent
+	switch {
+	case c >= 7:
+		return 1
+	case c >= 5:
+		return 2
+	case c >= 3:
+		return 3
+	case c > 0:
+		return 4
+	case c == 0:
+		return flatBucket
+	case c > -3:
+		return 6
+	case c > -5:
+		return 7
+	case c > -7:
+		return 8
+	}
+	return 9
+}
+
+// noPriceLimit reports whether the stock trades without a price limit: the first days
+// after listing, marked N (first day) or C (STAR and ChiNext first five days)
+func noPriceLimit(name string) bool {
+	return strings.HasPrefix(name, "N") || strings.HasPrefix(name, "C")
+}
+
+// ToModel converts b into the row stored for date
+func ToModel(date string, b *Breadth) *model.MarketBreadthDaily {
+	buckets, _ := json.Marshal(b.Buckets)
+	boards, _ := json.Marshal(b.Boards)
+	return &model.MarketBreadthDaily{
+		Date:      date,
+		Advance:   b.Advance,
+		Decline:   b.Decline,
+		Flat:      b.Flat,
+		Suspended: b.Suspended,
+		LimitUp:   b.LimitUp,
+		LimitDown: b.LimitDown,
+		Amount:    b.Amount,
+		Buckets:   string(buckets),
+		Boards:    string(boards),
+	}
+}
+
+// FromModel restores a stored row. Buckets and boards missing from it are left at zero.
+func FromModel(m *model.MarketBreadthDaily) *Breadth {
+	b := &Breadth{
+		Stats: Stats{
+			Advance:   m.Advance,
+			Decline:   m.Decline,
+			Flat:      m.Flat,
+			LimitUp:   m.LimitUp,
+			LimitDown: m.LimitDown,
+			Amount:    m.Amount,
+		},
+		Suspended: m.Suspended,
+		Buckets:   make([]int, len(BucketLabels)),
+		Boards:    make([]*Stats, len(Boards)),
+	}
+
+	var buckets []int
+	_ = json.Unmarshal([]byte(m.Buckets), &buckets)
+	copy(b.Buckets, buckets)
+
+	var boards []*Stats
+	_ = json.Unmarshal([]byte(m.Boards), &boards)
+	for i := range b.Boards {
+		b.Boards[i] = &Stats{}
+		if i < len(boards) && boards[i] != nil {
+			b.Boards[i] = boards[i]
+		}
+	}
+	return b
+}
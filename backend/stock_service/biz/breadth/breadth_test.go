@@ -0,0 +1,60 @@
+package breadth
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompute(t *testing.T) {
+	quotes := []*eastmoney.MarketQuote{
+		{Symbol: "600000", Exchange: "SH", Name: "浦发银行", PrevClose: 10, Price: 11, ChangePercent: 10, Amount: 100},
+		{Symbol: "600001", Exchange: "SH", Name: "ST某某", PrevClose: 10, Price: 10.5, ChangePercent: 5, Amount: 10}, // ST limit is 5%
+		{Symbol: "300001", Exchange: "SZ", Name: "特锐德", PrevClose: 10, Price: 11, ChangePercent: 10, Amount: 50},   // ChiNext limit is 20%
+		{Symbol: "688001", Exchange: "SH", Name: "华兴源创", PrevClose: 10, Price: 8, ChangePercent: -20, Amount: 20},
+		{Symbol: "301001", Exchange: "SZ", Name: "C新股", PrevClose: 10, Price: 30, ChangePercent: 200, Amount: 70}, // No limit after listing
+		{Symbol: "000002", Exchange: "SZ", Name: "万科A", PrevClose: 10, Price: 10, ChangePercent: 0, Amount: 30},
+		{Symbol: "000004", Exchange: "SZ", Name: "国华网安", PrevClose: 10, Price: 9.8, ChangePercent: -2, Amount: 5},
+		{Symbol: "920001", Exchange: "BJ", Name: "纬达光电", PrevClose: 10, Price: 9.4, ChangePercent: -6, Amount: 1},
+		{Symbol: "000001", Exchange: "SZ", Name: "平安银行", PrevClose: 10}, // Suspended
+	}
+
+	b := Compute(quotes)
+	assert.Equal(t, 1, b.Suspended)
+	assert.Equal(t, 4, b.Advance)
+	assert.Equal(t, 3, b.Decline)
+	assert.Equal(t, 1, b.Flat)
+	assert.Equal(t, 2, b.LimitUp)
+	assert.Equal(t, 1, b.LimitDown)
+	assert.Equal(t, 286.0, b.Amount)
+	assert.Equal(t, []int{2, 2, 0, 0, 0, 1, 1, 0, 1, 0, 1}, b.Buckets)
+
+	main, chinext, star, bse := b.Boards[0], b.Boards[1], b.Boards[2], b.Boards[3]
+	assert.Equal(t, 2, main.LimitUp)
+	assert.Equal(t, 1, main.Flat)
+	assert.Equal(t, 1, main.Decline)
+	assert.Equal(t, 2, chinext.Advance)
+	assert.Equal(t, 0, chinext.LimitUp)
+	assert.Equal(t, 1, star.LimitDown)
+	assert.Equal(t, 1, bse.Decline)
+	assert.Equal(t, 1.0, bse.Amount)
+}
+
+func TestModelRoundTrip(t *testing.T) {
+	b := Compute([]*eastmoney.MarketQuote{
+		{Symbol: "600000", Exchange: "SH", Name: "浦发银行", PrevClose: 10, Price: 11, ChangePercent: 10, Amount: 100},
+		{Symbol: "300001", Exchange: "SZ", Name: "特锐德", PrevClose: 10, Price: 9, ChangePercent: -10, Amount: 50},
+	})
+	m := ToModel("2025-09-30", b)
+	assert.Equal(t, "2025-09-30", m.Date)
+	assert.Equal(t, b, FromModel(m))
+
+	// Rows written before a bucket or board existed still restore
+	m.Buckets, m.Boards = "[1,2]", ""
+	restored := FromModel(m)
+	assert.Len(t, restored.Buckets, len(BucketLabels))
+	assert.Equal(t, 2, restored.Buckets[1])
+	assert.Len(t, restored.Boards, len(Boards))
+}
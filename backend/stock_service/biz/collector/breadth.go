@@ -0,0 +1,85 @@
+package collector
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/stock_service/biz/breadth"
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+	"stock_assistant/backend/stock_service/dal/mysql"
+)
+
+// QuoteSource provides the latest quotes of the whole market
+type QuoteSource interface {
+	GetMarketQuotes(ctx context.Context) ([]*eastmoney.MarketQuote, error)
+}
+
+// BreadthCollector stores the closing market breadth of each trading day
+// into market_breadth_dailies
+type BreadthCollector struct {
+	source QuoteSource
+}
+
+// NewBreadthCollector creates a collector reading the quotes from source
+func NewBreadthCollector(source QuoteSource) *BreadthCollector {
+	return &BreadthCollector{source: source}
+}
+
+// Start collects every trading day after the close until ctx is done. The quotes only
+// show the latest session, so a missed day can be caught up only before the next open.
+// Nothing runs without mysql.
+func (c *BreadthCollector) Start(ctx context.Context) {
+	if mysql.DB == nil {
+		log.Printf("Breadth collector: mysql not initialized, disabled")
+		return
+	}
+
+	go func() {
+		if date, ok := closedSession(calendar.Now()); ok {
+			if stored, err := mysql.LoadMarketBreadth(date); err == nil && stored == nil {
+				c.collectAndLog(ctx, date)
+			}
+		}
+
+		for {
+			timer := time.NewTimer(time.Until(nextCollectTime(calendar.Now(), breadthCollectMinute)))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				c.collectAndLog(ctx, calendar.Now().Format(calendar.DateLayout))
+			}
+		}
+	}()
+}
+
+func (c *BreadthCollector) collectAndLog(ctx context.Context, date string) {
+	if err := c.Collect(ctx, date); err != nil {
+		log.Printf("Breadth collector: collect %s failed: %v", date, err)
+		return
+	}
+	log.Printf("Breadth collector: collected %s", date)
+}
+
+// Collect computes the breadth from the current quotes and stores it as date,
+// it must run between the close of date and the next open
+func (c *BreadthCollector) Collect(ctx context.Context, date string) error {
+	quotes, err := c.source.GetMarketQuotes(ctx)
+	if err != nil {
+		return err
+	}
+	return mysql.SaveMarketBreadth(breadth.ToModel(date, breadth.Compute(quotes)))
+}
+
+// closedSession returns the session the live quotes show at t, if it has closed
+// and the next one has not opened yet
+func closedSession(t time.Time) (string, bool) {
+	completed := calendar.LatestCompletedTradingDay(t)
+	if !completed.Equal(calendar.LatestTradingDay(t)) {
+		return "", false
+	}
+	return completed.Format(calendar.DateLayout), true
+}
@@ -0,0 +1,20 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosedSession(t *testing.T) {
+	date, ok := closedSession(at("2025-09-30 16:00"))
+	assert.True(t, ok)
+	assert.Equal(t, "2025-09-30", date)
+
+	date, ok = closedSession(at("2025-10-09 08:00"))
+	assert.True(t, ok)
+	assert.Equal(t, "2025-09-30", date)
+
+	_, ok = closedSession(at("2025-09-30 10:00"))
+	assert.False(t, ok, "session running")
+}
@@ -0,0 +1,151 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+	"stock_assistant/backend/stock_service/dal/model"
+	"stock_assistant/backend/stock_service/dal/mysql"
+)
+
+// DefaultSectorInterval is how often the sector rankings are snapshotted during the sessions
+const DefaultSectorInterval = 5 * time.Minute
+
+// SectorRankLimit is above the number of concept boards, so a snapshot is ranked against all of them
+const SectorRankLimit = 1000
+
+// SectorSnapshotSize is how many sectors of each ranking a snapshot keeps. With 48 snapshots a
+// day at the default interval this bounds market_sector_dailies to about 15k rows a day, kept
+// indefinitely. Every industry board fits; the concepts ranked below are not stored, so their
+// timeline is empty and the rotation treats them as unranked.
+const SectorSnapshotSize = 200
+
+// sectorTypes are the rankings snapshotted by the SectorCollector
+var sectorTypes = []string{"concept", "industry"}
+
+// SectorSource provides the live sector rankings, ordered by change percent
+type SectorSource interface {
+	GetSectorRank(ctx context.Context, rankType string, limit int) ([]*eastmoney.SectorInfo, error)
+}
+
+// SectorCollector stores the concept and industry rankings into market_sector_dailies
+// every interval while the market trades
+type SectorCollector struct {
+	source   SectorSource
+	interval time.Duration
+}
+
+// NewSectorCollector creates a collector snapshotting source every interval
+func NewSectorCollector(source SectorSource, interval time.Duration) *SectorCollector {
+	if interval <= 0 {
+		interval = DefaultSectorInterval
+	}
+	return &SectorCollector{source: source, interval: interval}
+}
+
+// Start snapshots the rankings on every interval boundary within the sessions
+// (09:35, 09:40 ... 11:30, 13:05 ... 15:00 with the default interval) until ctx is done.
+// Nothing runs without mysql.
+func (c *SectorCollector) Start(ctx context.Context) {
+	if mysql.DB == nil {
+		log.Printf("Sector collector: mysql not initialized, disabled")
+		return
+	}
+
+	go func() {
+		for {
+			at := nextSnapshotTime(calendar.Now(), c.interval)
+			timer := time.NewTimer(time.Until(at))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				if err := c.Collect(ctx, at); err != nil {
+					log.Printf("Sector collector: snapshot %s failed: %v", at.Format("2006-01-02 15:04"), err)
+				}
+			}
+		}
+	}()
+}
+
+// Collect fetches the rankings and stores them as the snapshot taken at t.
+// A ranking that fails is skipped, the other one is still stored.
+func (c *SectorCollector) Collect(ctx context.Context, t time.Time) error {
+	var rows []*model.MarketSectorDaily
+	var failed []string
+	for _, rankType := range sectorTypes {
+		sectors, err := c.source.GetSectorRank(ctx, rankType, SectorRankLimit)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", rankType, err))
+			continue
+		}
+		rows = append(rows, BuildSectorSnapshot(t, rankType, sectors)...)
+	}
+
+	if err := mysql.SaveSectorSnapshot(rows); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%v", failed)
+	}
+	return nil
+}
+
+// BuildSectorSnapshot turns one ranking taken at t into rows, ranked in the given order.
+// Only the top SectorSnapshotSize are kept, Total still counts the whole ranking.
+func BuildSectorSnapshot(t time.Time, rankType string, sectors []*eastmoney.SectorInfo) []*model.MarketSectorDaily {
+	t = t.In(calendar.Location)
+	kept := sectors
+	if len(kept) > SectorSnapshotSize {
+		kept = kept[:SectorSnapshotSize]
+	}
+	rows := make([]*model.MarketSectorDaily, 0, len(kept))
+	for i, s := range kept {
+		rows = append(rows, &model.MarketSectorDaily{
+			Date:          t.Format(calendar.DateLayout),
+			Time:          t.Format("15:04:05"),
+			SectorCode:    s.Code,
+			Type:          rankType,
+			Name:          s.Name,
+			Rank:          i + 1,
+			Total:         len(sectors),
+			ChangePercent: s.ChangePercent,
+			NetInflow:     s.NetInflow,
+			TopStockName:  s.TopStockName,
+		})
+	}
+	return rows
+}
+
+// nextSnapshotTime returns the first interval boundary after t that falls in a session.
+// Boundaries count from midnight exchange time; a session's end is included,
+// its start is not, as nothing has traded yet.
+func nextSnapshotTime(t time.Time, interval time.Duration) time.Time {
+	t = t.In(calendar.Location)
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, calendar.Location)
+	at := midnight.Add(t.Sub(midnight).Truncate(interval) + interval)
+	for !inSession(at) {
+		if !calendar.IsTradingDay(at) {
+			// Skip to the next trading day at once rather than walking the night
+			at = calendar.NextTradingDay(at).Add(interval)
+			continue
+		}
+		at = at.Add(interval)
+	}
+	return at
+}
+
+// inSession reports whether the ranking at t reflects continuous trading,
+// i.e. the second before t was in a trading phase
+func inSession(t time.Time) bool {
+	switch calendar.Default().Phase(t.Add(-time.Second)) {
+	case calendar.PhaseMorning, calendar.PhaseAfternoon, calendar.PhaseClosingAuction:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package collector
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSectorSnapshot(t *testing.T) {
+	sectors := []*eastmoney.SectorInfo{
+		{Code: "BK1036", Name: "半导体", ChangePercent: 3.2, NetInflow: 1.5e9, TopStockName: "中芯国际"},
+		{Code: "BK0477", Name: "酿酒行业", ChangePercent: -0.8, NetInflow: -3e8},
+	}
+	// 02:35 UTC is 10:35 in Shanghai
+	rows := BuildSectorSnapshot(time.Date(2025, 9, 30, 2, 35, 0, 0, time.UTC), "industry", sectors)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, "2025-09-30", rows[0].Date)
+		assert.Equal(t, "10:35:00", rows[0].Time)
+		assert.Equal(t, "industry", rows[0].Type)
+		assert.Equal(t, 1, rows[0].Rank)
+		assert.Equal(t, 2, rows[1].Rank)
+		assert.Equal(t, 2, rows[1].Total)
+		assert.Equal(t, -3e8, rows[1].NetInflow)
+	}
+
+	// Only the top of a long ranking is stored, ranked against all of it
+	long := make([]*eastmoney.SectorInfo, SectorSnapshotSize+50)
+	for i := range long {
+		long[i] = &eastmoney.SectorInfo{Code: fmt.Sprintf("BK%04d", i)}
+	}
+	rows = BuildSectorSnapshot(time.Date(2025, 9, 30, 2, 35, 0, 0, time.UTC), "concept", long)
+	if assert.Len(t, rows, SectorSnapshotSize) {
+		assert.Equal(t, SectorSnapshotSize, rows[SectorSnapshotSize-1].Rank)
+		assert.Equal(t, SectorSnapshotSize+50, rows[0].Total)
+	}
+}
+
+func TestNextSnapshotTime(t *testing.T) {
+	cases := map[string]string{
+		"2025-09-30 08:00:00": "2025-09-30 09:35:00",
+		"2025-09-30 09:35:00": "2025-09-30 09:40:00",
+		"2025-09-30 10:31:12": "2025-09-30 10:35:00",
+		"2025-09-30 11:27:00": "2025-09-30 11:30:00",
+		"2025-09-30 11:30:00": "2025-09-30 13:05:00",
+		"2025-09-30 14:58:00": "2025-09-30 15:00:00",
+		// After the close, across the National Day holiday
+		"2025-09-30 15:00:00": "2025-10-09 09:35:00",
+	}
+	for from, want := range cases {
+		assert.Equal(t, at(want), nextSnapshotTime(at(from), DefaultSectorInterval), from)
+	}
+	assert.Equal(t, at("2025-09-30 10:30:00"), nextSnapshotTime(at("2025-09-30 10:00:00"), 30*time.Minute))
+}
@@ -0,0 +1,222 @@
+// Package collector persists end-of-day market data that the vendors only keep
+// for a few sessions.
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/stock_service/biz/provider/sentiment"
+	"stock_assistant/backend/stock_service/biz/temperature"
+	"stock_assistant/backend/stock_service/dal/model"
+	"stock_assistant/backend/stock_service/dal/mysql"
+)
+
+const (
+	// breadthCollectMinute and sentimentCollectMinute are when a day is collected, in
+	// minutes from midnight exchange time. The pools settle a little after the 15:00
+	// close, and the temperature needs the breadth of the day stored first.
+	breadthCollectMinute   = 15*60 + 30
+	sentimentCollectMinute = 15*60 + 40
+
+	// backfillDays is how many recent trading days are filled in on start,
+	// roughly what the vendor still serves.
+	backfillDays = 20
+)
+
+// PoolSource provides the daily limit-up, broken-board and limit-down pools
+type PoolSource interface {
+	GetLimitUpPool(ctx context.Context, date string) ([]*sentiment.LimitUpStock, error)
+	GetBrokenLimitPool(ctx context.Context, date string) ([]*sentiment.BrokenLimitStock, error)
+	GetLimitDownPool(ctx context.Context, date string) ([]*sentiment.LimitDownStock, error)
+}
+
+// SentimentCollector stores each trading day's limit-up pool and market temperature
+// into market_limit_up_summaries and market_limit_up_details
+type SentimentCollector struct {
+	source      PoolSource
+	temperature temperature.Config
+}
+
+// NewSentimentCollector creates a collector reading the pools from source and
+// scoring the days with cfg
+func NewSentimentCollector(source PoolSource, cfg temperature.Config) *SentimentCollector {
+	return &SentimentCollector{source: source, temperature: cfg}
+}
+
+// Start backfills the recent trading days missing from mysql, then collects every
+// trading day after the close until ctx is done. Nothing runs without mysql.
+func (c *SentimentCollector) Start(ctx context.Context) {
+	if mysql.DB == nil {
+		log.Printf("Sentiment collector: mysql not initialized, disabled")
+		return
+	}
+
+	go func() {
+		c.backfill(ctx)
+
+		for {
+			timer := time.NewTimer(time.Until(nextCollectTime(calendar.Now(), sentimentCollectMinute)))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				c.collectAndLog(ctx, calendar.Now().Format(calendar.DateLayout))
+			}
+		}
+	}()
+}
+
+func (c *SentimentCollector) backfill(ctx context.Context) {
+	end := calendar.LatestCompletedTradingDay(calendar.Now())
+	start := end
+	for i := 1; i < backfillDays; i++ {
+		start = calendar.PrevTradingDay(start)
+	}
+
+	stored, err := mysql.LoadLimitUpSummaries(start.Format(calendar.DateLayout), end.Format(calendar.DateLayout))
+	if err != nil {
+		log.Printf("Sentiment collector: load stored days failed: %v", err)
+		return
+	}
+	done := make(map[string]bool, len(stored))
+	for _, s := range stored {
+		done[s.Date] = true
+	}
+
+	for d := start; !d.After(end); d = calendar.NextTradingDay(d) {
+		if ctx.Err() != nil {
+			return
+		}
+		if date := d.Format(calendar.DateLayout); !done[date] {
+			c.collectAndLog(ctx, date)
+		}
+	}
+}
+
+func (c *SentimentCollector) collectAndLog(ctx context.Context, date string) {
+	if err := c.Collect(ctx, date); err != nil {
+		log.Printf("Sentiment collector: collect %s failed: %v", date, err)
+		return
+	}
+	log.Printf("Sentiment collector: collected %s", date)
+}
+
+// Collect fetches the pools of date (YYYY-MM-DD) and stores the day.
+// Collecting a date again overwrites what was stored for it.
+func (c *SentimentCollector) Collect(ctx context.Context, date string) error {
+	limitUps, err := c.source.GetLimitUpPool(ctx, date)
+	if err != nil {
+		return err
+	}
+	// The vendor answers an empty pool for dates it no longer (or not yet) serves,
+	// storing that would record a zero day
+	if len(limitUps) == 0 {
+		return fmt.Errorf("empty limit up pool")
+	}
+	broken, err := c.source.GetBrokenLimitPool(ctx, date)
+	if err != nil {
+		return err
+	}
+	limitDowns, err := c.source.GetLimitDownPool(ctx, date)
+	if err != nil {
+		return err
+	}
+
+	summary, details := BuildDay(date, limitUps, broken, limitDowns)
+	c.score(summary)
+	return mysql.SaveLimitUpDay(summary, details)
+}
+
+// score sets the temperature of summary, using the stored breadth of the day when there is one
+func (c *SentimentCollector) score(summary *model.MarketLimitUpSummary) {
+	day, err := mysql.LoadMarketBreadth(summary.Date)
+	if err != nil {
+		log.Printf("Sentiment collector: load breadth %s failed: %v", summary.Date, err)
+	}
+	var prev *model.MarketBreadthDaily
+	if day != nil {
+		if rows, err := mysql.LoadMarketBreadthBefore(summary.Date, 1); err == nil && len(rows) > 0 {
+			prev = rows[0]
+		}
+	}
+
+	result := c.temperature.Compute(TemperatureInputs(summary, day, prev))
+	summary.SentimentScore = result.Score
+	if components, err := json.Marshal(result.Components); err == nil {
+		summary.Components = string(components)
+	}
+}
+
+// TemperatureInputs gathers the temperature inputs of a day from its summary and breadth.
+// day and prev may be nil; prev only counts when it is the trading day right before.
+func TemperatureInputs(summary *model.MarketLimitUpSummary, day, prev *model.MarketBreadthDaily) temperature.Inputs {
+	in := temperature.Inputs{
+		LimitUp:      summary.LimitUpCount,
+		Broken:       summary.BrokenCount,
+		LimitDown:    summary.LimitDownCount,
+		HighestBoard: summary.HighestBoard,
+	}
+	if day == nil {
+		return in
+	}
+	in.HasBreadth = true
+	in.Advance, in.Decline = day.Advance, day.Decline
+
+	if prev == nil || prev.Amount <= 0 {
+		return in
+	}
+	d, err := time.ParseInLocation(calendar.DateLayout, summary.Date, calendar.Location)
+	if err != nil || calendar.PrevTradingDay(d).Format(calendar.DateLayout) != prev.Date {
+		return in
+	}
+	in.HasTurnover = true
+	in.TurnoverChange = (day.Amount/prev.Amount - 1) * 100
+	return in
+}
+
+// BuildDay turns the pools of one day into its summary and limit-up detail rows
+func BuildDay(date string, limitUps []*sentiment.LimitUpStock, broken []*sentiment.BrokenLimitStock, limitDowns []*sentiment.LimitDownStock) (*model.MarketLimitUpSummary, []*model.MarketLimitUpDetail) {
+	highest := 0
+	details := make([]*model.MarketLimitUpDetail, 0, len(limitUps))
+	for _, s := range limitUps {
+		if s.BoardCount > highest {
+			highest = s.BoardCount
+		}
+		details = append(details, &model.MarketLimitUpDetail{
+			Date:        date,
+			StockCode:   s.Code,
+			StockName:   s.Name,
+			BoardCount:  s.BoardCount,
+			LimitUpType: s.LimitUpType,
+			Reason:      s.Reason,
+			IsBroken:    s.IsBroken,
+		})
+	}
+
+	summary := &model.MarketLimitUpSummary{
+		Date:           date,
+		LimitUpCount:   len(limitUps),
+		BrokenCount:    len(broken),
+		LimitDownCount: len(limitDowns),
+		HighestBoard:   highest,
+	}
+	return summary, details
+}
+
+// nextCollectTime returns the first time after t that is minute (from midnight)
+// on a trading day
+func nextCollectTime(t time.Time, minute int) time.Time {
+	t = t.In(calendar.Location)
+	at := time.Date(t.Year(), t.Month(), t.Day(), 0, minute, 0, 0, calendar.Location)
+	if calendar.IsTradingDay(at) && at.After(t) {
+		return at
+	}
+	next := calendar.NextTradingDay(t)
+	return next.Add(time.Duration(minute) * time.Minute)
+}
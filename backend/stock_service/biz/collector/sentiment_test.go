@@ -0,0 +1,73 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/stock_service/biz/provider/sentiment"
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// at parses an exchange time, "2006-01-02 15:04" with optional seconds
+func at(s string) time.Time {
+	layout := "2006-01-02 15:04"
+	if len(s) > len(layout) {
+		layout += ":05"
+	}
+	t, err := time.ParseInLocation(layout, s, calendar.Location)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestBuildDay(t *testing.T) {
+	limitUps := []*sentiment.LimitUpStock{
+		{Code: "600519", Name: "贵州茅台", BoardCount: 1, LimitUpType: "首板", Reason: "白酒"},
+		{Code: "300750", Name: "宁德时代", BoardCount: 3, LimitUpType: "3连板", IsBroken: true},
+	}
+	broken := []*sentiment.BrokenLimitStock{{Code: "000001"}}
+	limitDowns := []*sentiment.LimitDownStock{{Code: "000002"}, {Code: "000003"}}
+
+	summary, details := BuildDay("2025-09-30", limitUps, broken, limitDowns)
+	assert.Equal(t, "2025-09-30", summary.Date)
+	assert.Equal(t, 2, summary.LimitUpCount)
+	assert.Equal(t, 1, summary.BrokenCount)
+	assert.Equal(t, 2, summary.LimitDownCount)
+	assert.Equal(t, 3, summary.HighestBoard)
+
+	if assert.Len(t, details, 2) {
+		assert.Equal(t, "2025-09-30", details[1].Date)
+		assert.Equal(t, "300750", details[1].StockCode)
+		assert.Equal(t, "3连板", details[1].LimitUpType)
+		assert.True(t, details[1].IsBroken)
+	}
+}
+
+func TestNextCollectTime(t *testing.T) {
+	assert.Equal(t, at("2025-09-30 15:30"), nextCollectTime(at("2025-09-30 10:00"), breadthCollectMinute))
+	// After the collect time, and across the National Day holiday
+	assert.Equal(t, at("2025-10-09 15:30"), nextCollectTime(at("2025-09-30 15:30"), breadthCollectMinute))
+	assert.Equal(t, at("2025-10-09 15:40"), nextCollectTime(at("2025-10-04 09:00"), sentimentCollectMinute))
+}
+
+func TestTemperatureInputs(t *testing.T) {
+	summary := &model.MarketLimitUpSummary{Date: "2025-10-09", LimitUpCount: 60, BrokenCount: 20, LimitDownCount: 5, HighestBoard: 4}
+
+	in := TemperatureInputs(summary, nil, nil)
+	assert.Equal(t, 60, in.LimitUp)
+	assert.False(t, in.HasBreadth)
+
+	day := &model.MarketBreadthDaily{Date: "2025-10-09", Advance: 3000, Decline: 2000, Amount: 1.1e12}
+	in = TemperatureInputs(summary, day, &model.MarketBreadthDaily{Date: "2025-09-30", Amount: 1e12})
+	assert.True(t, in.HasBreadth)
+	assert.Equal(t, 3000, in.Advance)
+	assert.True(t, in.HasTurnover, "09-30 is the trading day before 10-09")
+	assert.InDelta(t, 10.0, in.TurnoverChange, 1e-9)
+
+	in = TemperatureInputs(summary, day, &model.MarketBreadthDaily{Date: "2025-09-29", Amount: 1e12})
+	assert.False(t, in.HasTurnover, "a gap in the stored days")
+}
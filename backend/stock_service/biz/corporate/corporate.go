@@ -0,0 +1,46 @@
+// Package corporate keeps the corporate actions of a window: the restricted share
+// unlocks (限售解禁) and the dividend and bonus share plans (分红送配).
+package corporate
+
+import (
+	"sort"
+
+	"stock_assistant/backend/stock_service/biz/window"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Unlocks keeps the unlocks dated within the window, by date
+func Unlocks(w window.Window, unlocks []*stock.ShareUnlock) []*stock.ShareUnlock {
+	list := make([]*stock.ShareUnlock, 0)
+	for _, u := range unlocks {
+		if w.Contains(u.Date) {
+			list = append(list, u)
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Date < list[j].Date })
+	return list
+}
+
+// EventDate is when a plan matters to a holder: the ex-date once fixed, the notice date before
+func EventDate(d *stock.DividendPlan) string {
+	if d.ExDate != "" {
+		return d.ExDate
+	}
+	return d.NoticeDate
+}
+
+// Dividends keeps the plans whose EventDate falls within the window, by EventDate.
+// Plans paying nothing (不分配不转增) are dropped.
+func Dividends(w window.Window, plans []*stock.DividendPlan) []*stock.DividendPlan {
+	list := make([]*stock.DividendPlan, 0)
+	for _, d := range plans {
+		if d.CashPerTen == 0 && d.BonusPerTen == 0 && d.TransferPerTen == 0 {
+			continue
+		}
+		if w.Contains(EventDate(d)) {
+			list = append(list, d)
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool { return EventDate(list[i]) < EventDate(list[j]) })
+	return list
+}
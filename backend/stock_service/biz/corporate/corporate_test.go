@@ -0,0 +1,43 @@
+package corporate
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/biz/window"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnlocks(t *testing.T) {
+	w := window.Window{Start: "2024-04-10", End: "2024-04-24"}
+	unlocks := Unlocks(w, []*stock.ShareUnlock{
+		{Code: "300750", Date: "2024-04-22"},
+		{Code: "600519", Date: "2024-04-25"},
+		{Code: "000001", Date: "2024-04-10"},
+	})
+	if !assert.Len(t, unlocks, 2) {
+		return
+	}
+	assert.Equal(t, "000001", unlocks[0].Code)
+	assert.Equal(t, "300750", unlocks[1].Code)
+
+	assert.NotNil(t, Unlocks(w, nil))
+}
+
+func TestDividends(t *testing.T) {
+	w := window.Window{Start: "2024-04-10", End: "2024-04-24"}
+	plans := Dividends(w, []*stock.DividendPlan{
+		{Code: "600519", NoticeDate: "2024-04-02", ExDate: "2024-04-20", CashPerTen: 308.76},
+		{Code: "600519", NoticeDate: "2024-04-12", CashPerTen: 100}, // Ex-date not fixed yet
+		{Code: "000001", NoticeDate: "2024-03-15", ExDate: "2024-06-14", CashPerTen: 7.19},
+		{Code: "300750", NoticeDate: "2024-04-15", TransferPerTen: 4},
+		{Code: "000002", NoticeDate: "2024-04-11"}, // 不分配不转增
+	})
+	if !assert.Len(t, plans, 3) {
+		return
+	}
+	assert.Equal(t, "2024-04-12", EventDate(plans[0]))
+	assert.Equal(t, "300750", plans[1].Code)
+	assert.Equal(t, "2024-04-20", EventDate(plans[2]))
+}
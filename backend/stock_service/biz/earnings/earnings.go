@@ -0,0 +1,64 @@
+// Package earnings builds the earnings calendar: the scheduled report disclosures,
+// the performance previews (业绩预告) and the express reports (业绩快报) of a window.
+package earnings
+
+import (
+	"sort"
+	"time"
+
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/stock_service/biz/window"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// disclosureMonths is how long after its period end a report may come out,
+// the annual report is due by the end of April
+const disclosureMonths = 4
+
+// FirstPeriod is the oldest period end whose report can still come out within w
+func FirstPeriod(w window.Window) string {
+	start, _ := time.Parse(calendar.DateLayout, w.Start)
+	return start.AddDate(0, -disclosureMonths, 0).Format(calendar.DateLayout)
+}
+
+// DisclosureDate is when a report comes out: the actual date once published, the scheduled one before
+func DisclosureDate(d *stock.EarningsDisclosure) string {
+	if d.ActualDate != "" {
+		return d.ActualDate
+	}
+	return d.ScheduledDate
+}
+
+// Collect keeps what happens within the window and sorts it by date: the disclosures by
+// DisclosureDate, the previews and the express reports by notice date
+func Collect(w window.Window, disclosures []*stock.EarningsDisclosure, previews []*stock.EarningsPreview, expresses []*stock.EarningsExpress) *stock.GetEarningsCalendarResponse {
+	resp := &stock.GetEarningsCalendarResponse{
+		Start:       w.Start,
+		End:         w.End,
+		Disclosures: make([]*stock.EarningsDisclosure, 0),
+		Previews:    make([]*stock.EarningsPreview, 0),
+		Expresses:   make([]*stock.EarningsExpress, 0),
+	}
+	for _, d := range disclosures {
+		if w.Contains(DisclosureDate(d)) {
+			resp.Disclosures = append(resp.Disclosures, d)
+		}
+	}
+	for _, p := range previews {
+		if w.Contains(p.NoticeDate) {
+			resp.Previews = append(resp.Previews, p)
+		}
+	}
+	for _, e := range expresses {
+		if w.Contains(e.NoticeDate) {
+			resp.Expresses = append(resp.Expresses, e)
+		}
+	}
+
+	sort.SliceStable(resp.Disclosures, func(i, j int) bool {
+		return DisclosureDate(resp.Disclosures[i]) < DisclosureDate(resp.Disclosures[j])
+	})
+	sort.SliceStable(resp.Previews, func(i, j int) bool { return resp.Previews[i].NoticeDate < resp.Previews[j].NoticeDate })
+	sort.SliceStable(resp.Expresses, func(i, j int) bool { return resp.Expresses[i].NoticeDate < resp.Expresses[j].NoticeDate })
+	return resp
+}
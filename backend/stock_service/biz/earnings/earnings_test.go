@@ -0,0 +1,53 @@
+package earnings
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/biz/window"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstPeriod(t *testing.T) {
+	w := window.Window{Start: "2024-04-10", End: "2024-04-24"}
+	// The annual report of 2023 is still due
+	assert.Equal(t, "2023-12-10", FirstPeriod(w))
+}
+
+func TestCollect(t *testing.T) {
+	w := window.Window{Start: "2024-04-10", End: "2024-04-24"}
+	disclosures := []*stock.EarningsDisclosure{
+		{Code: "600519", ScheduledDate: "2024-04-20"},
+		{Code: "000001", ScheduledDate: "2024-04-30", ActualDate: "2024-04-12"}, // Published early
+		{Code: "300750", ScheduledDate: "2024-04-26"},
+	}
+	previews := []*stock.EarningsPreview{
+		{Code: "000002", NoticeDate: "2024-04-15"},
+		{Code: "000003", NoticeDate: "2024-04-09"},
+	}
+	expresses := []*stock.EarningsExpress{
+		{Code: "000004", NoticeDate: "2024-04-24"},
+		{Code: "000005", NoticeDate: "2024-04-11"},
+	}
+
+	resp := Collect(w, disclosures, previews, expresses)
+	assert.Equal(t, "2024-04-10", resp.Start)
+	if !assert.Len(t, resp.Disclosures, 2) {
+		return
+	}
+	assert.Equal(t, "000001", resp.Disclosures[0].Code)
+	assert.Equal(t, "600519", resp.Disclosures[1].Code)
+	if !assert.Len(t, resp.Previews, 1) {
+		return
+	}
+	assert.Equal(t, "000002", resp.Previews[0].Code)
+	if !assert.Len(t, resp.Expresses, 2) {
+		return
+	}
+	assert.Equal(t, "000005", resp.Expresses[0].Code)
+
+	empty := Collect(w, nil, nil, nil)
+	assert.NotNil(t, empty.Disclosures)
+	assert.Empty(t, empty.Previews)
+}
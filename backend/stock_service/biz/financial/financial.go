@@ -0,0 +1,161 @@
+// Package financial turns the published (year to date) financial statements into
+// single-quarter, annual and trailing twelve months views and derives ratios from them.
+package financial
+
+import (
+	"fmt"
+	"strconv"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Views of GetFinancialStatementsRequest
+const (
+	ViewReport  = "report"  // As published, income and cash flow year to date
+	ViewQuarter = "quarter" // Single quarter
+	ViewAnnual  = "annual"  // Full year reports only
+	ViewTTM     = "ttm"     // Trailing twelve months
+)
+
+// ReportsNeeded is how many published reports View needs for n periods of view
+func ReportsNeeded(view string, n int) int {
+	switch view {
+	case ViewAnnual:
+		return n * 4
+	case ViewQuarter, ViewTTM:
+		// The quarter before, or the same quarter and the full year of the year before
+		return n + 4
+	default:
+		return n
+	}
+}
+
+// View builds up to n periods of view, with their ratios, from the published reports.
+// Both are newest first. A period whose view needs a report that is missing is left out.
+func View(reports []*stock.FinancialPeriod, view string, n int) ([]*stock.FinancialPeriod, error) {
+	byDate := make(map[string]*stock.FinancialPeriod, len(reports))
+	for _, r := range reports {
+		byDate[r.ReportDate] = r
+	}
+
+	var result []*stock.FinancialPeriod
+	for _, r := range reports {
+		if len(result) == n {
+			break
+		}
+
+		var p *stock.FinancialPeriod
+		switch view {
+		case ViewReport:
+			p = derive(r, nil, nil)
+		case ViewAnnual:
+			if r.ReportType == "FY" {
+				p = derive(r, nil, nil)
+			}
+		case ViewQuarter:
+			if r.ReportType == "Q1" {
+				p = derive(r, nil, nil)
+			} else if prev, ok := byDate[previousQuarter(r.ReportDate)]; ok {
+				p = derive(r, nil, prev)
+			}
+		case ViewTTM:
+			if r.ReportType == "FY" {
+				p = derive(r, nil, nil)
+			} else {
+				year, _ := strconv.Atoi(r.ReportDate[:4])
+				lastYear := strconv.Itoa(year - 1)
+				fullYear, ok1 := byDate[lastYear+"-12-31"]
+				sameQuarter, ok2 := byDate[lastYear+r.ReportDate[4:]]
+				if ok1 && ok2 {
+					p = derive(r, fullYear, sameQuarter)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("invalid view %q, expected report, quarter, annual or ttm", view)
+		}
+		if p != nil {
+			p.Ratios = Ratios(p)
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// derive returns cur + add - sub for the income and cash flow statements, the balance
+// sheet is the one of cur. add and sub may be nil.
+func derive(cur, add, sub *stock.FinancialPeriod) *stock.FinancialPeriod {
+	income := *cur.Income
+	cashFlow := *cur.CashFlow
+	if add != nil {
+		addIncome(&income, add.Income, 1)
+		addCashFlow(&cashFlow, add.CashFlow, 1)
+	}
+	if sub != nil {
+		addIncome(&income, sub.Income, -1)
+		addCashFlow(&cashFlow, sub.CashFlow, -1)
+	}
+	return &stock.FinancialPeriod{
+		ReportDate: cur.ReportDate,
+		ReportType: cur.ReportType,
+		Income:     &income,
+		Balance:    cur.Balance,
+		CashFlow:   &cashFlow,
+	}
+}
+
+func addIncome(dst, src *stock.IncomeStatement, k float64) {
+	dst.TotalRevenue += k * src.TotalRevenue
+	dst.Revenue += k * src.Revenue
+	dst.OperatingCost += k * src.OperatingCost
+	dst.SellingExpense += k * src.SellingExpense
+	dst.AdminExpense += k * src.AdminExpense
+	dst.RdExpense += k * src.RdExpense
+	dst.FinanceExpense += k * src.FinanceExpense
+	dst.OperatingProfit += k * src.OperatingProfit
+	dst.TotalProfit += k * src.TotalProfit
+	dst.IncomeTax += k * src.IncomeTax
+	dst.NetProfit += k * src.NetProfit
+	dst.ParentNetProfit += k * src.ParentNetProfit
+	dst.DeductedNetProfit += k * src.DeductedNetProfit
+	dst.Eps += k * src.Eps
+}
+
+// addCashFlow leaves EndingCash alone, it is a balance rather than a flow
+func addCashFlow(dst, src *stock.CashFlowStatement, k float64) {
+	dst.OperatingCashFlow += k * src.OperatingCashFlow
+	dst.InvestingCashFlow += k * src.InvestingCashFlow
+	dst.FinancingCashFlow += k * src.FinancingCashFlow
+	dst.Capex += k * src.Capex
+	dst.NetCashChange += k * src.NetCashChange
+}
+
+// Ratios derives the ratios of one period, a ratio whose denominator is zero stays 0
+func Ratios(p *stock.FinancialPeriod) *stock.FinancialRatios {
+	div := func(a, b float64) float64 {
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	}
+	in, bs, cf := p.Income, p.Balance, p.CashFlow
+	return &stock.FinancialRatios{
+		Roe:            div(in.ParentNetProfit, bs.ParentEquity) * 100,
+		GrossMargin:    div(in.Revenue-in.OperatingCost, in.Revenue) * 100,
+		NetMargin:      div(in.ParentNetProfit, in.TotalRevenue) * 100,
+		DebtRatio:      div(bs.TotalLiabilities, bs.TotalAssets) * 100,
+		OcfToNetProfit: div(cf.OperatingCashFlow, in.ParentNetProfit),
+	}
+}
+
+// previousQuarter returns the report date of the quarter before date within the same year
+func previousQuarter(date string) string {
+	switch date[5:] {
+	case "06-30":
+		return date[:5] + "03-31"
+	case "09-30":
+		return date[:5] + "06-30"
+	case "12-31":
+		return date[:5] + "09-30"
+	}
+	return ""
+}
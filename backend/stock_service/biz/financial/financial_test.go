@@ -0,0 +1,131 @@
+package financial
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// report builds a published report whose year to date revenue and operating cash flow are given
+func report(date, reportType string, revenue, cashFlow float64) *stock.FinancialPeriod {
+	return &stock.FinancialPeriod{
+		ReportDate: date,
+		ReportType: reportType,
+		Income: &stock.IncomeStatement{
+			TotalRevenue:    revenue,
+			Revenue:         revenue,
+			OperatingCost:   revenue / 2,
+			ParentNetProfit: revenue / 4,
+		},
+		Balance: &stock.BalanceSheet{
+			TotalAssets:      1000,
+			TotalLiabilities: 400,
+			ParentEquity:     500,
+		},
+		CashFlow: &stock.CashFlowStatement{
+			OperatingCashFlow: cashFlow,
+			EndingCash:        300,
+		},
+	}
+}
+
+func sampleReports() []*stock.FinancialPeriod {
+	return []*stock.FinancialPeriod{
+		report("2024-06-30", "H1", 220, 110),
+		report("2024-03-31", "Q1", 100, 40),
+		report("2023-12-31", "FY", 400, 200),
+		report("2023-09-30", "Q3", 290, 150),
+		report("2023-06-30", "H1", 190, 90),
+		report("2023-03-31", "Q1", 90, 30),
+	}
+}
+
+func TestViewReport(t *testing.T) {
+	periods, err := View(sampleReports(), ViewReport, 3)
+	assert.Nil(t, err)
+	if !assert.Len(t, periods, 3) {
+		return
+	}
+	assert.Equal(t, "2024-06-30", periods[0].ReportDate)
+	assert.Equal(t, 220.0, periods[0].Income.Revenue)
+	assert.Equal(t, "2023-12-31", periods[2].ReportDate)
+}
+
+func TestViewQuarter(t *testing.T) {
+	reports := sampleReports()
+	periods, err := View(reports, ViewQuarter, 10)
+	assert.Nil(t, err)
+
+	// Every report has its previous quarter or is a Q1
+	if !assert.Len(t, periods, 6) {
+		return
+	}
+	assert.Equal(t, 120.0, periods[0].Income.Revenue) // 220 - 100
+	assert.Equal(t, 70.0, periods[0].CashFlow.OperatingCashFlow)
+	assert.Equal(t, 100.0, periods[1].Income.Revenue) // Q1 as published
+	assert.Equal(t, 110.0, periods[2].Income.Revenue) // 400 - 290
+	assert.Equal(t, 300.0, periods[0].CashFlow.EndingCash, "ending cash is a balance")
+
+	// The published reports are left alone
+	assert.Equal(t, 220.0, reports[0].Income.Revenue)
+}
+
+func TestViewQuarterSkipsMissingPrevious(t *testing.T) {
+	reports := []*stock.FinancialPeriod{
+		report("2024-06-30", "H1", 220, 110),
+		report("2023-12-31", "FY", 400, 200),
+	}
+	periods, err := View(reports, ViewQuarter, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, periods)
+}
+
+func TestViewAnnual(t *testing.T) {
+	periods, err := View(sampleReports(), ViewAnnual, 5)
+	assert.Nil(t, err)
+	if !assert.Len(t, periods, 1) {
+		return
+	}
+	assert.Equal(t, "2023-12-31", periods[0].ReportDate)
+}
+
+func TestViewTTM(t *testing.T) {
+	periods, err := View(sampleReports(), ViewTTM, 10)
+	assert.Nil(t, err)
+
+	// Only the 2024 reports and FY 2023 have a year before them to roll
+	if !assert.Len(t, periods, 3) {
+		return
+	}
+	assert.Equal(t, 430.0, periods[0].Income.Revenue) // 220 + 400 - 190
+	assert.Equal(t, 220.0, periods[0].CashFlow.OperatingCashFlow)
+	assert.Equal(t, 410.0, periods[1].Income.Revenue) // 100 + 400 - 90
+	assert.Equal(t, 400.0, periods[2].Income.Revenue)
+}
+
+func TestViewInvalid(t *testing.T) {
+	_, err := View(sampleReports(), "monthly", 4)
+	assert.Error(t, err)
+}
+
+func TestRatios(t *testing.T) {
+	r := Ratios(report("2023-12-31", "FY", 400, 200))
+	assert.InDelta(t, 20.0, r.Roe, 1e-9)         // 100 / 500
+	assert.InDelta(t, 50.0, r.GrossMargin, 1e-9) // (400 - 200) / 400
+	assert.InDelta(t, 25.0, r.NetMargin, 1e-9)
+	assert.InDelta(t, 40.0, r.DebtRatio, 1e-9)
+	assert.InDelta(t, 2.0, r.OcfToNetProfit, 1e-9)
+
+	empty := Ratios(report("2023-12-31", "FY", 0, 0))
+	assert.Zero(t, empty.GrossMargin)
+	assert.Zero(t, empty.OcfToNetProfit)
+}
+
+func TestReportsNeeded(t *testing.T) {
+	assert.Equal(t, 8, ReportsNeeded(ViewReport, 8))
+	assert.Equal(t, 12, ReportsNeeded(ViewQuarter, 8))
+	assert.Equal(t, 12, ReportsNeeded(ViewTTM, 8))
+	assert.Equal(t, 32, ReportsNeeded(ViewAnnual, 8))
+}
@@ -0,0 +1,79 @@
+// Package ladder builds the consecutive limit-up ladder (连板天梯).
+package ladder
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	stock "stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Level is one height of the ladder
+type Level struct {
+	Height int                   // Board count, 1 for 首板
+	Stocks []*stock.LimitUpStock // Earliest first seal first
+
+	// Promotion from the previous trading day, unset for height 1:
+	// PrevCount stocks closed at Height-1 boards, Promoted of them made Height today
+	PrevCount     int
+	Promoted      int
+	PromotionRate float64 // 晋级率 (%), 0 when PrevCount is 0
+}
+
+// Build groups today's pool by board height, highest first. prev maps the codes of the
+// previous trading day's pool to their board count; when it is nil the promotion
+// fields are left unset. A height every candidate failed to reach is still listed, empty.
+func Build(today []*stock.LimitUpStock, prev map[string]int) []*Level {
+	levels := make(map[int]*Level)
+	level := func(h int) *Level {
+		l, ok := levels[h]
+		if !ok {
+			l = &Level{Height: h, Stocks: []*stock.LimitUpStock{}}
+			levels[h] = l
+		}
+		return l
+	}
+
+	for _, s := range today {
+		h := int(s.BoardCount)
+		if h < 1 {
+			h = 1
+		}
+		l := level(h)
+		l.Stocks = append(l.Stocks, s)
+		if h > 1 && prev[s.Code] == h-1 {
+			l.Promoted++
+		}
+	}
+	for _, h := range prev {
+		if h >= 1 {
+			level(h+1).PrevCount++
+		}
+	}
+
+	result := make([]*Level, 0, len(levels))
+	for _, l := range levels {
+		if l.PrevCount > 0 {
+			l.PromotionRate = math.Round(float64(l.Promoted)/float64(l.PrevCount)*10000) / 100
+		}
+		sort.SliceStable(l.Stocks, func(i, j int) bool {
+			return l.Stocks[i].FirstSealTime < l.Stocks[j].FirstSealTime
+		})
+		result = append(result, l)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Height > result[j].Height })
+	return result
+}
+
+// TopConcept picks the concept of a stock from its boards, most relevant first:
+// the first one that is neither its industry nor a region (广东板块), empty if none
+func TopConcept(boards []string, industry string) string {
+	for _, b := range boards {
+		if b == "" || b == industry || strings.HasSuffix(b, "板块") {
+			continue
+		}
+		return b
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package ladder
+
+import (
+	"testing"
+
+	stock "stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuild(t *testing.T) {
+	today := []*stock.LimitUpStock{
+		{Code: "000001", BoardCount: 3, FirstSealTime: "09:35:00"},
+		{Code: "000002", BoardCount: 2, FirstSealTime: "10:00:00"},
+		{Code: "000003", BoardCount: 2, FirstSealTime: "09:31:00"},
+		{Code: "000004", BoardCount: 1, FirstSealTime: "13:00:00"},
+		// 2 boards today but not in yesterday's pool, e.g. data gap: not counted as promoted
+		{Code: "000005", BoardCount: 2, FirstSealTime: "14:00:00"},
+	}
+	prev := map[string]int{
+		"000001": 2, "000009": 2, // one of two 2-board stocks made 3
+		"000002": 1, "000003": 1, "000007": 1, "000008": 1, // two of four 首板 made 2
+		"000006": 4, // the 4-board leader failed
+	}
+
+	levels := Build(today, prev)
+	var heights []int
+	for _, l := range levels {
+		heights = append(heights, l.Height)
+	}
+	assert.Equal(t, []int{5, 3, 2, 1}, heights)
+
+	top := levels[0]
+	assert.Empty(t, top.Stocks)
+	assert.Equal(t, 1, top.PrevCount)
+	assert.Equal(t, 0.0, top.PromotionRate)
+
+	assert.Equal(t, 50.0, levels[1].PromotionRate)
+
+	two := levels[2]
+	assert.Equal(t, 4, two.PrevCount)
+	assert.Equal(t, 2, two.Promoted)
+	assert.Equal(t, 50.0, two.PromotionRate)
+	assert.Equal(t, "000003", two.Stocks[0].Code, "earliest seal first")
+	assert.Len(t, two.Stocks, 3)
+
+	assert.Equal(t, 0, levels[3].PrevCount)
+}
+
+func TestBuildWithoutHistory(t *testing.T) {
+	levels := Build([]*stock.LimitUpStock{{Code: "000001", BoardCount: 2}}, nil)
+	if assert.Len(t, levels, 1) {
+		assert.Equal(t, 2, levels[0].Height)
+		assert.Equal(t, 0, levels[0].PrevCount)
+	}
+}
+
+func TestTopConcept(t *testing.T) {
+	boards := []string{"半导体", "广东板块", "华为概念", "芯片概念"}
+	assert.Equal(t, "华为概念", TopConcept(boards, "半导体"))
+	assert.Equal(t, "", TopConcept([]string{"半导体", "广东板块"}, "半导体"))
+	assert.Equal(t, "", TopConcept(nil, "半导体"))
+}
@@ -6,8 +6,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/biz/provider"
 	"stock_assistant/backend/stock_service/kitex_gen/stock"
 )
 
@@ -23,6 +28,30 @@ func NewClient() *Client {
 	}
 }
 
+// getJSON fetches url and decodes the JSON body into v
+func (c *Client) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// An error page would otherwise decode into an empty result
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 type FinancialReportResponse struct {
 	Success bool `json:"success"`
 	Result  struct {
@@ -395,3 +424,121 @@ func (c *Client) fetchSeats(ctx context.Context, url string) ([]*DragonTigerSeat
 	}
 	return seats, nil
 }
+
+// --- Realtime Quote Support ---
+
+// flexFloat accepts both numbers and the "-" placeholder push2 returns for missing values
+type flexFloat float64
+
+func (f *flexFloat) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "-" || s == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f = flexFloat(v)
+	return nil
+}
+
+type QuoteResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Price         flexFloat `json:"f43"`
+		High          flexFloat `json:"f44"`
+		Low           flexFloat `json:"f45"`
+		Open          flexFloat `json:"f46"`
+		Volume        flexFloat `json:"f47"` // 手 (lots of 100 shares)
+		Amount        flexFloat `json:"f48"`
+		Code          string    `json:"f57"`
+		Name          string    `json:"f58"`
+		PrevClose     flexFloat `json:"f60"`
+		Timestamp     int64     `json:"f86"` // unix seconds
+		ChangePercent flexFloat `json:"f170"`
+
+		// Five-level book, volumes in 手
+		Buy5Price  flexFloat `json:"f11"`
+		Buy5Vol    flexFloat `json:"f12"`
+		Buy4Price  flexFloat `json:"f13"`
+		Buy4Vol    flexFloat `json:"f14"`
+		Buy3Price  flexFloat `json:"f15"`
+		Buy3Vol    flexFloat `json:"f16"`
+		Buy2Price  flexFloat `json:"f17"`
+		Buy2Vol    flexFloat `json:"f18"`
+		Buy1Price  flexFloat `json:"f19"`
+		Buy1Vol    flexFloat `json:"f20"`
+		Sell5Price flexFloat `json:"f31"`
+		Sell5Vol   flexFloat `json:"f32"`
+		Sell4Price flexFloat `json:"f33"`
+		Sell4Vol   flexFloat `json:"f34"`
+		Sell3Price flexFloat `json:"f35"`
+		Sell3Vol   flexFloat `json:"f36"`
+		Sell2Price flexFloat `json:"f37"`
+		Sell2Vol   flexFloat `json:"f38"`
+		Sell1Price flexFloat `json:"f39"`
+		Sell1Vol   flexFloat `json:"f40"`
+	} `json:"data"`
+}
+
+// Name implements provider.QuoteProvider
+func (c *Client) Name() string {
+	return "eastmoney"
+}
+
+// GetStockInfo fetches the realtime quote from push2 qt/stock/get
+func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInfo, error) {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	secID := sec.SecID()
+
+	url := fmt.Sprintf("https://push2.eastmoney.com/api/qt/stock/get?ut=fa5fd1943c7b386f172d6893dbfba10b&fltt=2&invt=2&secid=%s&fields=f11,f12,f13,f14,f15,f16,f17,f18,f19,f20,f31,f32,f33,f34,f35,f36,f37,f38,f39,f40,f43,f44,f45,f46,f47,f48,f57,f58,f60,f86,f170", secID)
+
+	var result QuoteResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Data == nil || result.Data.Name == "" {
+		return nil, fmt.Errorf("no quote returned from eastmoney for %s", code)
+	}
+
+	d := result.Data
+	timestamp := ""
+	if d.Timestamp > 0 {
+		timestamp = time.Unix(d.Timestamp, 0).In(calendar.Location).Format("2006-01-02 15:04:05")
+	}
+
+	info := &stock.StockInfo{
+		Code:          sec.Prefixed(),
+		Name:          d.Name,
+		CurrentPrice:  float64(d.Price),
+		ChangePercent: float64(d.ChangePercent),
+		Volume:        int64(d.Volume) * 100, // keep shares, same unit as Sina
+		Timestamp:     timestamp,
+		Open:          float64(d.Open),
+		High:          float64(d.High),
+		Low:           float64(d.Low),
+		PrevClose:     float64(d.PrevClose),
+		Amount:        float64(d.Amount),
+	}
+
+	level := func(price, lots flexFloat) *stock.OrderBookLevel {
+		return &stock.OrderBookLevel{Price: float64(price), Volume: int64(lots) * 100}
+	}
+	provider.SetOrderBook(info,
+		[]*stock.OrderBookLevel{
+			level(d.Buy1Price, d.Buy1Vol), level(d.Buy2Price, d.Buy2Vol), level(d.Buy3Price, d.Buy3Vol),
+			level(d.Buy4Price, d.Buy4Vol), level(d.Buy5Price, d.Buy5Vol),
+		},
+		[]*stock.OrderBookLevel{
+			level(d.Sell1Price, d.Sell1Vol), level(d.Sell2Price, d.Sell2Vol), level(d.Sell3Price, d.Sell3Vol),
+			level(d.Sell4Price, d.Sell4Vol), level(d.Sell5Price, d.Sell5Vol),
+		},
+	)
+	return info, nil
+}
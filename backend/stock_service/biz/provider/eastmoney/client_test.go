@@ -2,6 +2,8 @@ package eastmoney
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,3 +55,17 @@ func TestGetSectorRank(t *testing.T) {
 		assert.NotEmpty(t, s.Name)
 	}
 }
+
+func TestGetJSONStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"data":null}`))
+	}))
+	defer server.Close()
+
+	var v map[string]interface{}
+	err := NewClient().getJSON(context.Background(), server.URL, &v)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unexpected status 503")
+	}
+}
@@ -0,0 +1,48 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+)
+
+// --- Concept Board (核心题材) Support ---
+
+// conceptBatchSize bounds the codes of one call, a stock belongs to a dozen boards or more
+const conceptBatchSize = 20
+
+type ConceptBoardResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []struct {
+			SecurityCode string `json:"SECURITY_CODE"`
+			BoardName    string `json:"BOARD_NAME"`
+			BoardRank    int    `json:"BOARD_RANK"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetConceptBoards fetches the boards of each stock (6-digit codes) as listed in its F10
+// core themes, most relevant first. Industry and region boards are part of the list.
+func (c *Client) GetConceptBoards(ctx context.Context, codes []string) (map[string][]string, error) {
+	boards := make(map[string][]string, len(codes))
+	for start := 0; start < len(codes); start += conceptBatchSize {
+		end := start + conceptBatchSize
+		if end > len(codes) {
+			end = len(codes)
+		}
+		url := fmt.Sprintf("https://datacenter.eastmoney.com/securities/api/data/v1/get?reportName=RPT_F10_CORETHEME_BOARDTYPE&columns=SECURITY_CODE,BOARD_NAME,BOARD_RANK&filter=(SECURITY_CODE%%20in%%20(%s))(IS_PRECISE=%%221%%22)&pageNumber=1&pageSize=%d&sortTypes=1,1&sortColumns=SECURITY_CODE,BOARD_RANK&source=HSF10&client=PC",
+			quoteCodes(codes[start:end]), reportPageSize)
+
+		var result ConceptBoardResponse
+		if err := c.getJSON(ctx, url, &result); err != nil {
+			return nil, err
+		}
+		if result.Result == nil {
+			continue
+		}
+		for _, d := range result.Result.Data {
+			boards[d.SecurityCode] = append(boards[d.SecurityCode], d.BoardName)
+		}
+	}
+	return boards, nil
+}
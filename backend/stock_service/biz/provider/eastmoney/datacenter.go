@@ -0,0 +1,62 @@
+package eastmoney
+
+import (
+	"fmt"
+	"strings"
+)
+
+// --- Datacenter Report Paging ---
+
+const (
+	reportPageSize = 500
+	// maxReportPages bounds a query, a busy disclosure season or 10 years of daily valuations are a few thousand rows
+	maxReportPages = 20
+)
+
+// dateRangeFilter narrows a datacenter report to the rows of code (all stocks when empty)
+// whose dateColumn falls within [from, to], an empty from or to leaves that end open
+func dateRangeFilter(code, dateColumn, from, to string) string {
+	filter := ""
+	if code != "" {
+		filter = codeFilter(code)
+	}
+	if from != "" {
+		filter += fmt.Sprintf("(%s%%3E=%%27%s%%27)", dateColumn, from)
+	}
+	if to != "" {
+		filter += fmt.Sprintf("(%s%%3C=%%27%s%%27)", dateColumn, to)
+	}
+	return filter
+}
+
+// codeFilter narrows a datacenter report to the rows of one stock (6-digit code)
+func codeFilter(code string) string {
+	return fmt.Sprintf("(SECURITY_CODE=%%22%s%%22)", code)
+}
+
+// quoteCodes renders codes as the URL-encoded list of a datacenter in filter
+func quoteCodes(codes []string) string {
+	quoted := make([]string, 0, len(codes))
+	for _, code := range codes {
+		quoted = append(quoted, "%22"+code+"%22")
+	}
+	return strings.Join(quoted, ",")
+}
+
+// getReportPages calls fetch with the URL of page 1, 2... of a datacenter report, sorted by
+// sortColumn then code, until the last page. fetch decodes one page and returns the total
+// number of pages.
+func getReportPages(reportName, filter, sortColumn string, fetch func(url string) (int, error)) error {
+	for page := 1; page <= maxReportPages; page++ {
+		url := fmt.Sprintf("https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=%s&columns=ALL&filter=%s&pageNumber=%d&pageSize=%d&sortTypes=1,1&sortColumns=%s,SECURITY_CODE",
+			reportName, filter, page, reportPageSize, sortColumn)
+		pages, err := fetch(url)
+		if err != nil {
+			return err
+		}
+		if page >= pages {
+			return nil
+		}
+	}
+	return nil
+}
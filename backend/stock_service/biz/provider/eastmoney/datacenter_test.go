@@ -0,0 +1,47 @@
+package eastmoney
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRangeFilter(t *testing.T) {
+	assert.Equal(t, "(NOTICE_DATE%3E=%272024-04-10%27)(NOTICE_DATE%3C=%272024-04-24%27)",
+		dateRangeFilter("", "NOTICE_DATE", "2024-04-10", "2024-04-24"))
+	assert.Equal(t, "(SECURITY_CODE=%22600519%22)(REPORT_DATE%3E=%272023-12-10%27)(REPORT_DATE%3C=%272024-04-24%27)",
+		dateRangeFilter("600519", "REPORT_DATE", "2023-12-10", "2024-04-24"))
+	assert.Equal(t, "(SECURITY_CODE=%22600519%22)(TRADE_DATE%3E=%272024-01-01%27)",
+		dateRangeFilter("600519", "TRADE_DATE", "2024-01-01", ""))
+	assert.Equal(t, "(SECURITY_CODE=%22600519%22)", codeFilter("600519"))
+	assert.Equal(t, "%22600519%22,%22000001%22", quoteCodes([]string{"600519", "000001"}))
+}
+
+func TestGetReportPages(t *testing.T) {
+	var urls []string
+	err := getReportPages("RPT_PUBLIC_OP_NEWPREDICT", "(X)", "NOTICE_DATE", func(url string) (int, error) {
+		urls = append(urls, url)
+		return 3, nil
+	})
+	assert.Nil(t, err)
+	if !assert.Len(t, urls, 3) {
+		return
+	}
+	assert.True(t, strings.Contains(urls[2], "reportName=RPT_PUBLIC_OP_NEWPREDICT&columns=ALL&filter=(X)&pageNumber=3"))
+
+	// An empty result (0 pages) stops after the first page, an error is returned as is
+	calls := 0
+	err = getReportPages("R", "(X)", "D", func(url string) (int, error) {
+		calls++
+		return 0, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+
+	err = getReportPages("R", "(X)", "D", func(url string) (int, error) {
+		return 0, fmt.Errorf("boom")
+	})
+	assert.EqualError(t, err, "boom")
+}
@@ -0,0 +1,71 @@
+package eastmoney
+
+import (
+	"context"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Dividend (分红送配) Support ---
+
+type DividendResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Pages int `json:"pages"`
+		Data  []struct {
+			SecurityCode string    `json:"SECURITY_CODE"`
+			SecurityName string    `json:"SECURITY_NAME_ABBR"`
+			ReportDate   string    `json:"REPORT_DATE"`
+			NoticeDate   string    `json:"PLAN_NOTICE_DATE"`
+			RecordDate   string    `json:"EQUITY_RECORD_DATE"`
+			ExDate       string    `json:"EX_DIVIDEND_DATE"`
+			Cash         flexFloat `json:"PRETAX_BONUS_RMB"`
+			Bonus        flexFloat `json:"BONUS_RATIO"`
+			Transfer     flexFloat `json:"IT_RATIO"`
+			Progress     string    `json:"ASSIGN_PROGRESS"`
+			Plan         string    `json:"IMPL_PLAN_PROFILE"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetStockDividends fetches every dividend plan of one stock (6-digit code), oldest first.
+// A stock has a few plans a year, and the ones still pending have no ex-date to filter on.
+func (c *Client) GetStockDividends(ctx context.Context, code string) ([]*stock.DividendPlan, error) {
+	return c.getDividends(ctx, codeFilter(code), "PLAN_NOTICE_DATE")
+}
+
+// GetDividends fetches the dividend plans of the market going ex within [from, to]
+func (c *Client) GetDividends(ctx context.Context, from, to string) ([]*stock.DividendPlan, error) {
+	return c.getDividends(ctx, dateRangeFilter("", "EX_DIVIDEND_DATE", from, to), "EX_DIVIDEND_DATE")
+}
+
+func (c *Client) getDividends(ctx context.Context, filter, sortColumn string) ([]*stock.DividendPlan, error) {
+	var list []*stock.DividendPlan
+	err := getReportPages("RPT_SHAREBONUS_DET", filter, sortColumn,
+		func(url string) (int, error) {
+			var result DividendResponse
+			if err := c.getJSON(ctx, url, &result); err != nil {
+				return 0, err
+			}
+			if result.Result == nil {
+				return 0, nil
+			}
+			for _, d := range result.Result.Data {
+				list = append(list, &stock.DividendPlan{
+					Code:           d.SecurityCode,
+					Name:           d.SecurityName,
+					ReportDate:     datePart(d.ReportDate),
+					NoticeDate:     datePart(d.NoticeDate),
+					RecordDate:     datePart(d.RecordDate),
+					ExDate:         datePart(d.ExDate),
+					CashPerTen:     float64(d.Cash),
+					BonusPerTen:    float64(d.Bonus),
+					TransferPerTen: float64(d.Transfer),
+					Progress:       d.Progress,
+					Plan:           d.Plan,
+				})
+			}
+			return result.Result.Pages, nil
+		})
+	return list, err
+}
@@ -0,0 +1,170 @@
+package eastmoney
+
+import (
+	"context"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Earnings Calendar (业绩披露) Support ---
+
+type EarningsDisclosureResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Pages int `json:"pages"`
+		Data  []struct {
+			SecurityCode     string `json:"SECURITY_CODE"`
+			SecurityName     string `json:"SECURITY_NAME_ABBR"`
+			ReportDate       string `json:"REPORT_DATE"`
+			FirstAppointDate string `json:"FIRST_APPOINT_DATE"`
+			FirstChangeDate  string `json:"FIRST_CHANGE_DATE"`
+			SecondChangeDate string `json:"SECOND_CHANGE_DATE"`
+			ThirdChangeDate  string `json:"THIRD_CHANGE_DATE"`
+			ActualDate       string `json:"ACTUAL_PUBLISH_DATE"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetEarningsDisclosures fetches the scheduled disclosures (预约披露) of the reports whose
+// period ends within [firstPeriod, lastPeriod], of one stock (6-digit code) or of the market
+func (c *Client) GetEarningsDisclosures(ctx context.Context, code, firstPeriod, lastPeriod string) ([]*stock.EarningsDisclosure, error) {
+	var list []*stock.EarningsDisclosure
+	err := getReportPages("RPT_PUBLIC_BS_APPOIN", dateRangeFilter(code, "REPORT_DATE", firstPeriod, lastPeriod), "REPORT_DATE",
+		func(url string) (int, error) {
+			var result EarningsDisclosureResponse
+			if err := c.getJSON(ctx, url, &result); err != nil {
+				return 0, err
+			}
+			// The datacenter answers success=false when nothing matches
+			if result.Result == nil {
+				return 0, nil
+			}
+			for _, d := range result.Result.Data {
+				report := datePart(d.ReportDate)
+				disclosure := &stock.EarningsDisclosure{
+					Code:               d.SecurityCode,
+					Name:               d.SecurityName,
+					ReportDate:         report,
+					ReportType:         reportTypes[monthOf(report)],
+					FirstScheduledDate: datePart(d.FirstAppointDate),
+					ScheduledDate:      datePart(d.FirstAppointDate),
+					ActualDate:         datePart(d.ActualDate),
+				}
+				for _, changed := range []string{d.FirstChangeDate, d.SecondChangeDate, d.ThirdChangeDate} {
+					if changed != "" {
+						disclosure.ScheduledDate = datePart(changed)
+						disclosure.Reschedules++
+					}
+				}
+				list = append(list, disclosure)
+			}
+			return result.Result.Pages, nil
+		})
+	return list, err
+}
+
+type EarningsPreviewResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Pages int `json:"pages"`
+		Data  []struct {
+			SecurityCode   string    `json:"SECURITY_CODE"`
+			SecurityName   string    `json:"SECURITY_NAME_ABBR"`
+			ReportDate     string    `json:"REPORT_DATE"`
+			NoticeDate     string    `json:"NOTICE_DATE"`
+			PredictFinance string    `json:"PREDICT_FINANCE"`
+			PredictType    string    `json:"PREDICT_TYPE"`
+			AmountLower    flexFloat `json:"PREDICT_AMT_LOWER"`
+			AmountUpper    flexFloat `json:"PREDICT_AMT_UPPER"`
+			ChangeLower    flexFloat `json:"ADD_AMP_LOWER"`
+			ChangeUpper    flexFloat `json:"ADD_AMP_UPPER"`
+			LastYear       flexFloat `json:"PREYEAR_SAME_PERIOD"`
+			Reason         string    `json:"CHANGE_REASON_EXPLAIN"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetEarningsPreviews fetches the performance previews (业绩预告) noticed within [from, to],
+// of one stock (6-digit code) or of the market. A preview has one row per forecast item.
+func (c *Client) GetEarningsPreviews(ctx context.Context, code, from, to string) ([]*stock.EarningsPreview, error) {
+	var list []*stock.EarningsPreview
+	err := getReportPages("RPT_PUBLIC_OP_NEWPREDICT", dateRangeFilter(code, "NOTICE_DATE", from, to), "NOTICE_DATE",
+		func(url string) (int, error) {
+			var result EarningsPreviewResponse
+			if err := c.getJSON(ctx, url, &result); err != nil {
+				return 0, err
+			}
+			if result.Result == nil {
+				return 0, nil
+			}
+			for _, d := range result.Result.Data {
+				list = append(list, &stock.EarningsPreview{
+					Code:        d.SecurityCode,
+					Name:        d.SecurityName,
+					ReportDate:  datePart(d.ReportDate),
+					NoticeDate:  datePart(d.NoticeDate),
+					Indicator:   d.PredictFinance,
+					Type:        d.PredictType,
+					Lower:       float64(d.AmountLower),
+					Upper:       float64(d.AmountUpper),
+					ChangeLower: float64(d.ChangeLower),
+					ChangeUpper: float64(d.ChangeUpper),
+					LastYear:    float64(d.LastYear),
+					Reason:      d.Reason,
+				})
+			}
+			return result.Result.Pages, nil
+		})
+	return list, err
+}
+
+type EarningsExpressResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Pages int `json:"pages"`
+		Data  []struct {
+			SecurityCode    string    `json:"SECURITY_CODE"`
+			SecurityName    string    `json:"SECURITY_NAME_ABBR"`
+			ReportDate      string    `json:"REPORT_DATE"`
+			NoticeDate      string    `json:"UPDATE_DATE"`
+			Revenue         flexFloat `json:"TOTAL_OPERATE_INCOME"`
+			RevenueYoy      flexFloat `json:"YSTZ"`
+			ParentNetProfit flexFloat `json:"PARENT_NETPROFIT"`
+			NetProfitYoy    flexFloat `json:"JLRTBZCL"`
+			BasicEPS        flexFloat `json:"BASIC_EPS"`
+			WeightAvgROE    flexFloat `json:"WEIGHTAVG_ROE"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetEarningsExpresses fetches the express reports (业绩快报) noticed within [from, to],
+// of one stock (6-digit code) or of the market
+func (c *Client) GetEarningsExpresses(ctx context.Context, code, from, to string) ([]*stock.EarningsExpress, error) {
+	var list []*stock.EarningsExpress
+	err := getReportPages("RPT_FCI_PERFORMANCEE", dateRangeFilter(code, "UPDATE_DATE", from, to), "UPDATE_DATE",
+		func(url string) (int, error) {
+			var result EarningsExpressResponse
+			if err := c.getJSON(ctx, url, &result); err != nil {
+				return 0, err
+			}
+			if result.Result == nil {
+				return 0, nil
+			}
+			for _, d := range result.Result.Data {
+				list = append(list, &stock.EarningsExpress{
+					Code:         d.SecurityCode,
+					Name:         d.SecurityName,
+					ReportDate:   datePart(d.ReportDate),
+					NoticeDate:   datePart(d.NoticeDate),
+					Revenue:      float64(d.Revenue),
+					RevenueYoy:   float64(d.RevenueYoy),
+					NetProfit:    float64(d.ParentNetProfit),
+					NetProfitYoy: float64(d.NetProfitYoy),
+					Eps:          float64(d.BasicEPS),
+					Roe:          float64(d.WeightAvgROE),
+				})
+			}
+			return result.Result.Pages, nil
+		})
+	return list, err
+}
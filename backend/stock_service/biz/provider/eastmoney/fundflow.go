@@ -0,0 +1,222 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Fund Flow (资金流向) Support ---
+
+// push2his keeps about half a year of daily fund flow
+const maxFundFlowDays = 120
+
+// GetStockFundFlowDays fetches the net inflow by order size of the last days sessions, oldest first
+func (c *Client) GetStockFundFlowDays(ctx context.Context, code string, days int) (string, []*stock.FundFlowDay, error) {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return "", nil, err
+	}
+	if days <= 0 || days > maxFundFlowDays {
+		days = maxFundFlowDays
+	}
+
+	// fields2: f51 date, f52 main, f53 small, f54 medium, f55 large, f56 super large (net CNY),
+	// f57-f61 the same as % of turnover, f62 close, f63 change pct
+	url := fmt.Sprintf("https://push2his.eastmoney.com/api/qt/stock/fflow/daykline/get?ut=fa5fd1943c7b386f172d6893dbfba10b&secid=%s&fields1=f1,f2,f3,f7&fields2=f51,f52,f53,f54,f55,f56,f57,f58,f59,f60,f61,f62,f63,f64,f65&klt=101&lmt=%d",
+		sec.SecID(), days)
+
+	var result KLineResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return "", nil, err
+	}
+	if result.Data == nil {
+		return "", nil, fmt.Errorf("no fund flow data for %s", code)
+	}
+
+	flows := make([]*stock.FundFlowDay, 0, len(result.Data.KLines))
+	for _, line := range result.Data.KLines {
+		day, err := parseFundFlowDay(line)
+		if err != nil {
+			return "", nil, err
+		}
+		flows = append(flows, day)
+	}
+	return result.Data.Name, flows, nil
+}
+
+// GetStockFundFlowIntraday fetches the cumulative minute net inflow of the latest session
+func (c *Client) GetStockFundFlowIntraday(ctx context.Context, code string) (string, []*stock.FundFlowMinute, error) {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// fields2: f51 time, f52 main, f53 small, f54 medium, f55 large, f56 super large
+	url := fmt.Sprintf("https://push2.eastmoney.com/api/qt/stock/fflow/kline/get?ut=fa5fd1943c7b386f172d6893dbfba10b&secid=%s&fields1=f1,f2,f3,f7&fields2=f51,f52,f53,f54,f55,f56&klt=1&lmt=0",
+		sec.SecID())
+
+	var result KLineResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return "", nil, err
+	}
+	if result.Data == nil {
+		return "", nil, fmt.Errorf("no intraday fund flow data for %s", code)
+	}
+
+	var date string
+	points := make([]*stock.FundFlowMinute, 0, len(result.Data.KLines))
+	for _, line := range result.Data.KLines {
+		day, point, err := parseFundFlowMinute(line)
+		if err != nil {
+			return "", nil, err
+		}
+		date = day
+		points = append(points, point)
+	}
+	return date, points, nil
+}
+
+// parseFundFlowDay parses one "date,main,small,medium,large,super,main%,small%,medium%,large%,super%,close,pct" line
+func parseFundFlowDay(line string) (*stock.FundFlowDay, error) {
+	parts := strings.Split(line, ",")
+	if len(parts) < 13 {
+		return nil, fmt.Errorf("unexpected fund flow format: %s", line)
+	}
+
+	num := func(i int) float64 {
+		v, _ := strconv.ParseFloat(parts[i], 64)
+		return v
+	}
+	return &stock.FundFlowDay{
+		Date:          parts[0],
+		MainNet:       num(1),
+		SmallNet:      num(2),
+		MediumNet:     num(3),
+		LargeNet:      num(4),
+		SuperLargeNet: num(5),
+		MainNetRatio:  num(6),
+		Close:         num(11),
+		ChangePercent: num(12),
+	}, nil
+}
+
+// parseFundFlowMinute parses one "YYYY-MM-DD HH:MM,main,small,medium,large,super" line
+func parseFundFlowMinute(line string) (string, *stock.FundFlowMinute, error) {
+	parts := strings.Split(line, ",")
+	if len(parts) < 6 {
+		return "", nil, fmt.Errorf("unexpected fund flow format: %s", line)
+	}
+	dateTime := strings.SplitN(parts[0], " ", 2)
+	if len(dateTime) != 2 {
+		return "", nil, fmt.Errorf("unexpected fund flow time: %s", parts[0])
+	}
+
+	num := func(i int) float64 {
+		v, _ := strconv.ParseFloat(parts[i], 64)
+		return v
+	}
+	return dateTime[0], &stock.FundFlowMinute{
+		Time:          dateTime[1],
+		MainNet:       num(1),
+		SmallNet:      num(2),
+		MediumNet:     num(3),
+		LargeNet:      num(4),
+		SuperLargeNet: num(5),
+	}, nil
+}
+
+// sectorFlowSortFields maps the periods of GetSectorFundFlowRank to the clist field it sorts by
+var sectorFlowSortFields = map[string]string{
+	"today": "f62",
+	"5d":    "f164",
+	"10d":   "f174",
+}
+
+type SectorFundFlowResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Total int `json:"total"`
+		Diff  []struct {
+			Code            string    `json:"f12"`
+			Name            string    `json:"f14"`
+			ChangePercent   flexFloat `json:"f3"`
+			MainNet         flexFloat `json:"f62"`
+			MainNetRatio    flexFloat `json:"f184"`
+			TopStockName    string    `json:"f204"`
+			Change5D        flexFloat `json:"f109"`
+			MainNet5D       flexFloat `json:"f164"`
+			MainNetRatio5D  flexFloat `json:"f165"`
+			TopStock5D      string    `json:"f257"`
+			Change10D       flexFloat `json:"f160"`
+			MainNet10D      flexFloat `json:"f174"`
+			MainNetRatio10D flexFloat `json:"f175"`
+			TopStock10D     string    `json:"f260"`
+		} `json:"diff"`
+	} `json:"data"`
+}
+
+// SectorFundFlow is the main-force money flow of one sector over a period
+type SectorFundFlow struct {
+	Code           string
+	Name           string
+	ChangePercent  float64 // Over the period
+	MainNet        float64 // Over the period (CNY)
+	MainNetRatio   float64 // % of turnover
+	TopStockName   string  // Largest main net inflow of the period
+	TodayNetInflow float64 // Main net inflow of the latest session
+}
+
+// GetSectorFundFlowRank fetches the concept or industry sectors by main net inflow
+// over period ("today", "5d" or "10d"), largest inflow first
+func (c *Client) GetSectorFundFlowRank(ctx context.Context, rankType, period string, limit int) ([]*SectorFundFlow, error) {
+	fid, ok := sectorFlowSortFields[period]
+	if !ok {
+		return nil, fmt.Errorf("unsupported fund flow period: %s", period)
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	fs := "m:90+t:3+f:!50"
+	if rankType == "industry" {
+		fs = "m:90+t:2+f:!50"
+	}
+
+	url := fmt.Sprintf("https://push2.eastmoney.com/api/qt/clist/get?pn=1&pz=%d&po=1&np=1&ut=bd1d9ddb04089700cf9c27f6f7426281&fltt=2&invt=2&fid=%s&fs=%s&fields=f12,f14,f3,f62,f184,f204,f109,f164,f165,f257,f160,f174,f175,f260",
+		limit, fid, fs)
+
+	var result SectorFundFlowResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+	if result.Data == nil {
+		return nil, fmt.Errorf("no sector fund flow data returned from eastmoney")
+	}
+
+	flows := make([]*SectorFundFlow, 0, len(result.Data.Diff))
+	for _, d := range result.Data.Diff {
+		flow := &SectorFundFlow{
+			Code:           d.Code,
+			Name:           d.Name,
+			ChangePercent:  float64(d.ChangePercent),
+			MainNet:        float64(d.MainNet),
+			MainNetRatio:   float64(d.MainNetRatio),
+			TopStockName:   d.TopStockName,
+			TodayNetInflow: float64(d.MainNet),
+		}
+		switch period {
+		case "5d":
+			flow.ChangePercent, flow.MainNet = float64(d.Change5D), float64(d.MainNet5D)
+			flow.MainNetRatio, flow.TopStockName = float64(d.MainNetRatio5D), d.TopStock5D
+		case "10d":
+			flow.ChangePercent, flow.MainNet = float64(d.Change10D), float64(d.MainNet10D)
+			flow.MainNetRatio, flow.TopStockName = float64(d.MainNetRatio10D), d.TopStock10D
+		}
+		flows = append(flows, flow)
+	}
+	return flows, nil
+}
@@ -0,0 +1,60 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFundFlowDay(t *testing.T) {
+	day, err := parseFundFlowDay("2024-05-15,-152345678.0,80123456.0,72222222.0,-52345678.0,-100000000.0,-7.26,3.82,3.44,-2.49,-4.77,1700.00,0.59,0.00,0.00")
+	assert.Nil(t, err)
+	assert.Equal(t, "2024-05-15", day.Date)
+	assert.Equal(t, -152345678.0, day.MainNet)
+	assert.Equal(t, 80123456.0, day.SmallNet)
+	assert.Equal(t, 72222222.0, day.MediumNet)
+	assert.Equal(t, -52345678.0, day.LargeNet)
+	assert.Equal(t, -100000000.0, day.SuperLargeNet)
+	assert.Equal(t, -7.26, day.MainNetRatio)
+	assert.Equal(t, 1700.00, day.Close)
+	assert.Equal(t, 0.59, day.ChangePercent)
+
+	_, err = parseFundFlowDay("2024-05-15,-152345678.0")
+	assert.NotNil(t, err)
+}
+
+func TestParseFundFlowMinute(t *testing.T) {
+	date, point, err := parseFundFlowMinute("2024-05-15 09:31,1200000.0,-300000.0,-900000.0,200000.0,1000000.0")
+	assert.Nil(t, err)
+	assert.Equal(t, "2024-05-15", date)
+	assert.Equal(t, "09:31", point.Time)
+	assert.Equal(t, 1200000.0, point.MainNet)
+	assert.Equal(t, -300000.0, point.SmallNet)
+	assert.Equal(t, -900000.0, point.MediumNet)
+	assert.Equal(t, 200000.0, point.LargeNet)
+	assert.Equal(t, 1000000.0, point.SuperLargeNet)
+
+	_, _, err = parseFundFlowMinute("09:31,1200000.0,-300000.0,-900000.0,200000.0,1000000.0")
+	assert.NotNil(t, err)
+}
+
+func TestGetStockFundFlowDays(t *testing.T) {
+	client := NewClient()
+	name, days, err := client.GetStockFundFlowDays(context.Background(), "600519", 5)
+	if err != nil {
+		t.Logf("Failed to get fund flow: %v", err)
+		return
+	}
+	assert.NotEmpty(t, name)
+	assert.LessOrEqual(t, len(days), 5)
+	for _, d := range days {
+		t.Logf("%+v", d)
+	}
+}
+
+func TestGetSectorFundFlowRankInvalidPeriod(t *testing.T) {
+	client := NewClient()
+	_, err := client.GetSectorFundFlowRank(context.Background(), "concept", "3d", 10)
+	assert.NotNil(t, err)
+}
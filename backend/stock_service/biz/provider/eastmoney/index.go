@@ -0,0 +1,131 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Index Overview Support ---
+
+type IndexListResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Total int `json:"total"`
+		Diff  []struct {
+			Price         flexFloat `json:"f2"`
+			ChangePercent flexFloat `json:"f3"`
+			Change        flexFloat `json:"f4"`
+			Amount        flexFloat `json:"f6"`
+			Code          string    `json:"f12"`
+			Market        int       `json:"f13"`
+			Name          string    `json:"f14"`
+			UpCount       flexFloat `json:"f104"`
+			DownCount     flexFloat `json:"f105"`
+			FlatCount     flexFloat `json:"f106"`
+		} `json:"diff"`
+	} `json:"data"`
+}
+
+// GetIndexQuotes fetches several indices in one ulist call, including the
+// up/down/flat member counts. Bare codes are read as indices (000001 is
+// 上证指数, not 平安银行), codes with an exchange are taken as given.
+// The result follows the order of codes, unknown codes are left out.
+func (c *Client) GetIndexQuotes(ctx context.Context, codes []string) ([]*stock.IndexQuote, error) {
+	secIDs := make([]string, 0, len(codes))
+	bySecID := make(map[string]string, len(codes))
+	for _, code := range codes {
+		sec, err := symbol.ParseIndex(code)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported index code: %w", err)
+		}
+		secID := sec.SecID()
+		secIDs = append(secIDs, secID)
+		bySecID[secID] = sec.Prefixed()
+	}
+	if len(secIDs) == 0 {
+		return nil, nil
+	}
+
+	url := fmt.Sprintf("https://push2.eastmoney.com/api/qt/ulist.np/get?ut=fa5fd1943c7b386f172d6893dbfba10b&fltt=2&invt=2&secids=%s&fields=f2,f3,f4,f6,f12,f13,f14,f104,f105,f106",
+		strings.Join(secIDs, ","))
+
+	var result IndexListResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+	if result.Data == nil {
+		return nil, fmt.Errorf("no index data returned")
+	}
+
+	quotes := make(map[string]*stock.IndexQuote, len(result.Data.Diff))
+	for _, d := range result.Data.Diff {
+		code, ok := bySecID[fmt.Sprintf("%d.%s", d.Market, d.Code)]
+		if !ok {
+			continue
+		}
+		quotes[code] = &stock.IndexQuote{
+			Code:          code,
+			Name:          d.Name,
+			CurrentPrice:  float64(d.Price),
+			Change:        float64(d.Change),
+			ChangePercent: float64(d.ChangePercent),
+			Amount:        float64(d.Amount),
+			UpCount:       int32(d.UpCount),
+			DownCount:     int32(d.DownCount),
+			FlatCount:     int32(d.FlatCount),
+		}
+	}
+
+	res := make([]*stock.IndexQuote, 0, len(quotes))
+	for _, secID := range secIDs {
+		if q, ok := quotes[bySecID[secID]]; ok {
+			res = append(res, q)
+			delete(quotes, bySecID[secID])
+		}
+	}
+	return res, nil
+}
+
+// GetIndexCloses rebuilds the overview of a past session from the daily K-line: the close
+// of the last session on or before date against the one before. The member counts are
+// only published live and are left at 0.
+func (c *Client) GetIndexCloses(ctx context.Context, codes []string, date string) ([]*stock.IndexQuote, error) {
+	res := make([]*stock.IndexQuote, 0, len(codes))
+	for _, code := range codes {
+		sec, err := symbol.ParseIndex(code)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported index code: %w", err)
+		}
+		name, bars, err := c.GetKLine(ctx, sec.Prefixed(), KLineQuery{Period: "day", Adjust: "none", EndDate: date, Limit: 2})
+		if err != nil {
+			return nil, err
+		}
+		if q := indexClose(sec.Prefixed(), name, bars); q != nil {
+			res = append(res, q)
+		}
+	}
+	return res, nil
+}
+
+// indexClose turns the last two daily bars of an index into a quote of the last one, nil without bars
+func indexClose(code, name string, bars []*stock.KLineBar) *stock.IndexQuote {
+	if len(bars) == 0 {
+		return nil
+	}
+	last := bars[len(bars)-1]
+	q := &stock.IndexQuote{
+		Code:          code,
+		Name:          name,
+		CurrentPrice:  last.Close,
+		ChangePercent: last.ChangePercent,
+		Amount:        last.Amount,
+	}
+	if len(bars) > 1 {
+		q.Change = last.Close - bars[len(bars)-2].Close
+	}
+	return q
+}
@@ -0,0 +1,45 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIndexQuotesInvalidCode(t *testing.T) {
+	client := NewClient()
+	_, err := client.GetIndexQuotes(context.Background(), []string{"sh000001", "hk00700"})
+	assert.NotNil(t, err)
+}
+
+func TestGetIndexQuotes(t *testing.T) {
+	client := NewClient()
+	quotes, err := client.GetIndexQuotes(context.Background(), []string{"sh000001", "sz399006", "bj899050"})
+	if err != nil {
+		t.Logf("Failed to get index quotes: %v", err)
+		return
+	}
+	for _, q := range quotes {
+		t.Logf("%+v", q)
+	}
+	if len(quotes) > 0 {
+		assert.Equal(t, "sh000001", quotes[0].Code)
+	}
+}
+
+func TestIndexClose(t *testing.T) {
+	assert.Nil(t, indexClose("sh000001", "上证指数", nil))
+
+	q := indexClose("sh000001", "上证指数", []*stock.KLineBar{
+		{Date: "2025-09-29", Close: 3862.53},
+		{Date: "2025-09-30", Close: 3882.78, ChangePercent: 0.52, Amount: 8.9e11},
+	})
+	assert.Equal(t, "上证指数", q.Name)
+	assert.Equal(t, 3882.78, q.CurrentPrice)
+	assert.InDelta(t, 20.25, q.Change, 1e-9)
+	assert.Equal(t, 0.52, q.ChangePercent)
+	assert.Equal(t, int32(0), q.UpCount)
+}
@@ -0,0 +1,123 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Intraday (分时) Support ---
+
+// push2his keeps minute data for the last 5 sessions
+const maxIntradayDays = 5
+
+type TrendsResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Code     string   `json:"code"`
+		Name     string   `json:"name"`
+		PreClose float64  `json:"preClose"` // Close before the first returned session
+		Trends   []string `json:"trends"`   // "2024-05-15 09:31,open,price,high,low,volume,amount,avg"
+	} `json:"data"`
+}
+
+// IntradayTrend is the minute line of one session
+type IntradayTrend struct {
+	Name      string
+	Date      string
+	PrevClose float64
+	Points    []*stock.IntradayPoint
+}
+
+// GetIntradayTrend fetches the minute line of date (YYYY-MM-DD), or of the latest session when date is empty.
+// Only the last 5 sessions are available.
+func (c *Client) GetIntradayTrend(ctx context.Context, code, date string) (*IntradayTrend, error) {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	secID := sec.SecID()
+
+	ndays := 1
+	if date != "" {
+		ndays = maxIntradayDays
+	}
+
+	url := fmt.Sprintf("https://push2his.eastmoney.com/api/qt/stock/trends2/get?ut=fa5fd1943c7b386f172d6893dbfba10b&secid=%s&fields1=f1,f2,f3,f4,f5,f6,f7,f8,f9,f10,f11,f12,f13&fields2=f51,f52,f53,f54,f55,f56,f57,f58&iscr=0&ndays=%d", secID, ndays)
+
+	var result TrendsResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+	if result.Data == nil || len(result.Data.Trends) == 0 {
+		return nil, fmt.Errorf("no intraday data for %s", code)
+	}
+
+	trend, err := pickSession(result.Data.Trends, result.Data.PreClose, date)
+	if err != nil {
+		return nil, err
+	}
+	trend.Name = result.Data.Name
+	return trend, nil
+}
+
+// pickSession groups the trend lines by day and returns the requested one (the last one when date is empty).
+// The previous close of a later session is the last price of the session before it.
+func pickSession(lines []string, firstPrevClose float64, date string) (*IntradayTrend, error) {
+	var sessions []*IntradayTrend
+	for _, line := range lines {
+		day, point, err := parseTrendLine(line)
+		if err != nil {
+			return nil, err
+		}
+		if len(sessions) == 0 || sessions[len(sessions)-1].Date != day {
+			prevClose := firstPrevClose
+			if len(sessions) > 0 {
+				prev := sessions[len(sessions)-1].Points
+				prevClose = prev[len(prev)-1].Price
+			}
+			sessions = append(sessions, &IntradayTrend{Date: day, PrevClose: prevClose})
+		}
+		last := sessions[len(sessions)-1]
+		last.Points = append(last.Points, point)
+	}
+
+	if date == "" {
+		return sessions[len(sessions)-1], nil
+	}
+	for _, s := range sessions {
+		if s.Date == date {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("no intraday data for %s, only the last %d sessions are available", date, maxIntradayDays)
+}
+
+// parseTrendLine parses one "YYYY-MM-DD HH:MM,open,price,high,low,volume,amount,avg" line
+func parseTrendLine(line string) (string, *stock.IntradayPoint, error) {
+	parts := strings.Split(line, ",")
+	if len(parts) < 8 {
+		return "", nil, fmt.Errorf("unexpected trend format: %s", line)
+	}
+	dateTime := strings.SplitN(parts[0], " ", 2)
+	if len(dateTime) != 2 {
+		return "", nil, fmt.Errorf("unexpected trend time: %s", parts[0])
+	}
+
+	price, _ := strconv.ParseFloat(parts[2], 64)
+	volume, _ := strconv.ParseFloat(parts[5], 64)
+	amount, _ := strconv.ParseFloat(parts[6], 64)
+	avg, _ := strconv.ParseFloat(parts[7], 64)
+
+	return dateTime[0], &stock.IntradayPoint{
+		Time:     dateTime[1],
+		Price:    price,
+		AvgPrice: avg,
+		Volume:   int64(volume) * 100, // keep shares, same unit as the quotes
+		Amount:   amount,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickSession(t *testing.T) {
+	lines := []string{
+		"2024-05-14 09:30,10.00,10.00,10.00,10.00,100,100000.00,10.000",
+		"2024-05-14 15:00,10.10,10.20,10.20,10.10,200,204000.00,10.050",
+		"2024-05-15 09:30,10.30,10.30,10.30,10.30,50,51500.00,10.300",
+		"2024-05-15 09:31,10.30,10.40,10.40,10.30,80,83200.00,10.360",
+	}
+
+	latest, err := pickSession(lines, 9.90, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "2024-05-15", latest.Date)
+	assert.Equal(t, 10.20, latest.PrevClose)
+	if assert.Len(t, latest.Points, 2) {
+		assert.Equal(t, "09:31", latest.Points[1].Time)
+		assert.Equal(t, 10.40, latest.Points[1].Price)
+		assert.Equal(t, 10.36, latest.Points[1].AvgPrice)
+		assert.Equal(t, int64(8000), latest.Points[1].Volume)
+	}
+
+	first, err := pickSession(lines, 9.90, "2024-05-14")
+	assert.Nil(t, err)
+	assert.Equal(t, 9.90, first.PrevClose)
+	assert.Len(t, first.Points, 2)
+
+	_, err = pickSession(lines, 9.90, "2024-05-01")
+	assert.NotNil(t, err)
+}
+
+func TestGetIntradayTrend(t *testing.T) {
+	client := NewClient()
+	trend, err := client.GetIntradayTrend(context.Background(), "600519", "")
+	if err != nil {
+		t.Logf("Failed to get intraday trend: %v", err)
+		return
+	}
+	assert.NotEmpty(t, trend.Points)
+	t.Logf("%s %s prev close %.2f, %d points", trend.Name, trend.Date, trend.PrevClose, len(trend.Points))
+}
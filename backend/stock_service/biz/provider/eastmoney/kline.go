@@ -0,0 +1,157 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- K-line Support ---
+
+// klinePeriods maps the period names of GetKLineRequest to push2his klt values
+var klinePeriods = map[string]int{
+	"1m":    1,
+	"5m":    5,
+	"15m":   15,
+	"30m":   30,
+	"60m":   60,
+	"day":   101,
+	"week":  102,
+	"month": 103,
+}
+
+// klineAdjusts maps the adjust modes of GetKLineRequest to push2his fqt values
+var klineAdjusts = map[string]int{
+	"none":     0,
+	"forward":  1, // 前复权
+	"backward": 2, // 后复权
+}
+
+const (
+	defaultKLineLimit = 120
+	// Upper bound on lmt when a start date is given, the range itself limits the result
+	maxKLineLimit = 10000
+)
+
+// KLineQuery selects the bars returned by GetKLine, empty fields use the defaults
+type KLineQuery struct {
+	Period    string // default "day"
+	Adjust    string // default "forward"
+	StartDate string // YYYY-MM-DD
+	EndDate   string // YYYY-MM-DD
+	Limit     int
+}
+
+type KLineResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Code   string   `json:"code"`
+		Name   string   `json:"name"`
+		KLines []string `json:"klines"`
+	} `json:"data"`
+}
+
+// GetKLine fetches historical bars from push2his, oldest first
+func (c *Client) GetKLine(ctx context.Context, code string, q KLineQuery) (string, []*stock.KLineBar, error) {
+	if q.Period == "" {
+		q.Period = "day"
+	}
+	if q.Adjust == "" {
+		q.Adjust = "forward"
+	}
+	klt, ok := klinePeriods[q.Period]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported kline period: %s", q.Period)
+	}
+	fqt, ok := klineAdjusts[q.Adjust]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported kline adjust mode: %s", q.Adjust)
+	}
+
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return "", nil, err
+	}
+	secID := sec.SecID()
+
+	beg, err := compactDate(q.StartDate, "0")
+	if err != nil {
+		return "", nil, err
+	}
+	end, err := compactDate(q.EndDate, "20500101")
+	if err != nil {
+		return "", nil, err
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultKLineLimit
+		if q.StartDate != "" {
+			limit = maxKLineLimit
+		}
+	}
+
+	// fields2: f51 date, f52 open, f53 close, f54 high, f55 low, f56 volume (手), f57 amount,
+	// f58 amplitude, f59 change pct, f60 change, f61 turnover rate
+	url := fmt.Sprintf("https://push2his.eastmoney.com/api/qt/stock/kline/get?ut=fa5fd1943c7b386f172d6893dbfba10b&secid=%s&fields1=f1,f2,f3&fields2=f51,f52,f53,f54,f55,f56,f57,f58,f59,f60,f61&klt=%d&fqt=%d&beg=%s&end=%s&lmt=%d",
+		secID, klt, fqt, beg, end, limit)
+
+	var result KLineResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return "", nil, err
+	}
+	if result.Data == nil {
+		return "", nil, fmt.Errorf("no kline data for %s", code)
+	}
+
+	bars := make([]*stock.KLineBar, 0, len(result.Data.KLines))
+	for _, line := range result.Data.KLines {
+		bar, err := parseKLine(line)
+		if err != nil {
+			return "", nil, err
+		}
+		bars = append(bars, bar)
+	}
+	return result.Data.Name, bars, nil
+}
+
+// parseKLine parses one "date,open,close,high,low,volume,amount,amplitude,pct,change,turnover" line
+func parseKLine(line string) (*stock.KLineBar, error) {
+	parts := strings.Split(line, ",")
+	if len(parts) < 11 {
+		return nil, fmt.Errorf("unexpected kline format: %s", line)
+	}
+
+	num := func(i int) float64 {
+		v, _ := strconv.ParseFloat(parts[i], 64)
+		return v
+	}
+	return &stock.KLineBar{
+		Date:          parts[0],
+		Open:          num(1),
+		Close:         num(2),
+		High:          num(3),
+		Low:           num(4),
+		Volume:        int64(num(5)) * 100, // keep shares, same unit as the quotes
+		Amount:        num(6),
+		ChangePercent: num(8),
+		TurnoverRate:  num(10),
+	}, nil
+}
+
+// compactDate converts YYYY-MM-DD to the YYYYMMDD form push2his expects
+func compactDate(date, def string) (string, error) {
+	if date == "" {
+		return def, nil
+	}
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	return t.Format("20060102"), nil
+}
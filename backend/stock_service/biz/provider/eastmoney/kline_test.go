@@ -0,0 +1,49 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKLine(t *testing.T) {
+	bar, err := parseKLine("2024-05-15,1695.00,1700.00,1710.00,1688.00,12345,2098765432.00,1.30,0.59,10.00,0.10")
+	assert.Nil(t, err)
+	assert.Equal(t, "2024-05-15", bar.Date)
+	assert.Equal(t, 1695.00, bar.Open)
+	assert.Equal(t, 1700.00, bar.Close)
+	assert.Equal(t, 1710.00, bar.High)
+	assert.Equal(t, 1688.00, bar.Low)
+	assert.Equal(t, int64(1234500), bar.Volume)
+	assert.Equal(t, 2098765432.00, bar.Amount)
+	assert.Equal(t, 0.59, bar.ChangePercent)
+	assert.Equal(t, 0.10, bar.TurnoverRate)
+
+	_, err = parseKLine("2024-05-15,1695.00")
+	assert.NotNil(t, err)
+}
+
+func TestGetKLineInvalidQuery(t *testing.T) {
+	client := NewClient()
+	_, _, err := client.GetKLine(context.Background(), "600519", KLineQuery{Period: "2h"})
+	assert.NotNil(t, err)
+	_, _, err = client.GetKLine(context.Background(), "600519", KLineQuery{Adjust: "qfq"})
+	assert.NotNil(t, err)
+	_, _, err = client.GetKLine(context.Background(), "600519", KLineQuery{StartDate: "20240101"})
+	assert.NotNil(t, err)
+}
+
+func TestGetKLine(t *testing.T) {
+	client := NewClient()
+	name, bars, err := client.GetKLine(context.Background(), "600519", KLineQuery{Period: "week", Limit: 10})
+	if err != nil {
+		t.Logf("Failed to get kline: %v", err)
+		return
+	}
+	assert.NotEmpty(t, name)
+	assert.LessOrEqual(t, len(bars), 10)
+	for _, b := range bars {
+		t.Logf("%+v", b)
+	}
+}
@@ -0,0 +1,120 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Margin Trading (融资融券) Support ---
+
+// marginRow holds the balance columns the per-stock and the market reports share
+type marginRow struct {
+	RZYE   float64 `json:"RZYE"`   // 融资余额
+	RZMRE  float64 `json:"RZMRE"`  // 融资买入额
+	RZCHE  float64 `json:"RZCHE"`  // 融资偿还额
+	RZJME  float64 `json:"RZJME"`  // 融资净买入额
+	RQYE   float64 `json:"RQYE"`   // 融券余额
+	RQYL   float64 `json:"RQYL"`   // 融券余量 (shares)
+	RZRQYE float64 `json:"RZRQYE"` // 融资融券余额
+}
+
+func (r marginRow) toThrift(date string) *stock.MarginDay {
+	return &stock.MarginDay{
+		Date:             datePart(date),
+		FinancingBalance: r.RZYE,
+		FinancingBuy:     r.RZMRE,
+		FinancingRepay:   r.RZCHE,
+		FinancingNetBuy:  r.RZJME,
+		LendingBalance:   r.RQYE,
+		LendingVolume:    r.RQYL,
+		TotalBalance:     r.RZRQYE,
+	}
+}
+
+type StockMarginResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []struct {
+			marginRow
+			Date    string  `json:"DATE"`
+			SecName string  `json:"SECNAME"`
+			Close   float64 `json:"SPJ"`
+			Change  float64 `json:"ZDF"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetStockMargin fetches the margin balances of one stock (6-digit code) over the last
+// days sessions, oldest first
+func (c *Client) GetStockMargin(ctx context.Context, code string, days int) (string, []*stock.MarginDay, error) {
+	// One extra day to compute the change of the oldest one
+	url := fmt.Sprintf("https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=RPTA_WEB_RZRQ_GGMX&columns=ALL&filter=(SCODE=%%22%s%%22)&pageNumber=1&pageSize=%d&sortTypes=-1&sortColumns=DATE",
+		code, days+1)
+
+	var result StockMarginResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return "", nil, err
+	}
+	if result.Result == nil || len(result.Result.Data) == 0 {
+		return "", nil, fmt.Errorf("no margin trading data for %s", code)
+	}
+
+	rows := result.Result.Data
+	list := make([]*stock.MarginDay, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		day := rows[i].toThrift(rows[i].Date)
+		day.Close = rows[i].Close
+		day.ChangePercent = rows[i].Change
+		list = append(list, day)
+	}
+	return rows[0].SecName, marginChanges(list, days), nil
+}
+
+type MarketMarginResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []struct {
+			marginRow
+			Date string `json:"DIM_DATE"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetMarketMargin fetches the margin balances of the whole market (SH + SZ + BJ)
+// over the last days sessions, oldest first
+func (c *Client) GetMarketMargin(ctx context.Context, days int) ([]*stock.MarginDay, error) {
+	url := fmt.Sprintf("https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=RPTA_RZRQ_LSHJ&columns=ALL&pageNumber=1&pageSize=%d&sortTypes=-1&sortColumns=DIM_DATE",
+		days+1)
+
+	var result MarketMarginResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+	if result.Result == nil || len(result.Result.Data) == 0 {
+		return nil, fmt.Errorf("no market margin data returned from eastmoney")
+	}
+
+	rows := result.Result.Data
+	list := make([]*stock.MarginDay, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		list = append(list, rows[i].toThrift(rows[i].Date))
+	}
+	return marginChanges(list, days), nil
+}
+
+// marginChanges fills the day-over-day balance changes of list (oldest first) and keeps
+// its last days entries. The oldest entry has no previous day and keeps zero changes.
+func marginChanges(list []*stock.MarginDay, days int) []*stock.MarginDay {
+	for i := 1; i < len(list); i++ {
+		prev, day := list[i-1], list[i]
+		day.FinancingBalanceChange = day.FinancingBalance - prev.FinancingBalance
+		day.LendingBalanceChange = day.LendingBalance - prev.LendingBalance
+		day.TotalBalanceChange = day.TotalBalance - prev.TotalBalance
+	}
+	if len(list) > days {
+		list = list[len(list)-days:]
+	}
+	return list
+}
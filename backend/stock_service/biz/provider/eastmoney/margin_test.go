@@ -0,0 +1,46 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarginChanges(t *testing.T) {
+	list := []*stock.MarginDay{
+		{Date: "2024-05-13", FinancingBalance: 100, LendingBalance: 10, TotalBalance: 110},
+		{Date: "2024-05-14", FinancingBalance: 120, LendingBalance: 8, TotalBalance: 128},
+		{Date: "2024-05-15", FinancingBalance: 115, LendingBalance: 9, TotalBalance: 124},
+	}
+
+	days := marginChanges(list, 2)
+	assert.Len(t, days, 2)
+	assert.Equal(t, "2024-05-14", days[0].Date)
+	assert.Equal(t, 20.0, days[0].FinancingBalanceChange)
+	assert.Equal(t, -2.0, days[0].LendingBalanceChange)
+	assert.Equal(t, 18.0, days[0].TotalBalanceChange)
+	assert.Equal(t, -5.0, days[1].FinancingBalanceChange)
+	assert.Equal(t, -4.0, days[1].TotalBalanceChange)
+
+	// Fewer rows than asked, the oldest has no previous day
+	days = marginChanges([]*stock.MarginDay{{Date: "2024-05-15", FinancingBalance: 115}}, 5)
+	assert.Len(t, days, 1)
+	assert.Equal(t, 0.0, days[0].FinancingBalanceChange)
+}
+
+func TestGetStockMargin(t *testing.T) {
+	client := NewClient()
+	name, days, err := client.GetStockMargin(context.Background(), "600519", 5)
+	if err != nil {
+		t.Logf("Failed to get margin trading: %v", err)
+		return
+	}
+	assert.NotEmpty(t, name)
+	assert.LessOrEqual(t, len(days), 5)
+	for _, d := range days {
+		t.Logf("%+v", d)
+	}
+}
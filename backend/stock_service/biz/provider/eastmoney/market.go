@@ -0,0 +1,92 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// --- Whole Market Quotes Support ---
+
+// MarketQuote is the latest quote of one A-share stock in the full market list
+type MarketQuote struct {
+	Symbol        string // 600519
+	Exchange      string // SH, SZ, BJ
+	Name          string
+	Price         float64 // 0 when suspended
+	ChangePercent float64
+	PrevClose     float64
+	Amount        float64 // CNY
+}
+
+type MarketQuoteResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Total int `json:"total"`
+		Diff  []struct {
+			Code          string    `json:"f12"`
+			Market        int       `json:"f13"`
+			Name          string    `json:"f14"`
+			Price         flexFloat `json:"f2"`
+			ChangePercent flexFloat `json:"f3"`
+			Amount        flexFloat `json:"f6"`
+			PrevClose     flexFloat `json:"f18"`
+		} `json:"diff"`
+	} `json:"data"`
+}
+
+// GetMarketQuotes pages through clist and returns the quotes of every listed A-share stock
+func (c *Client) GetMarketQuotes(ctx context.Context) ([]*MarketQuote, error) {
+	var quotes []*MarketQuote
+	for _, g := range securityGroups {
+		if g.secType != "stock" {
+			continue
+		}
+		groupQuotes, err := c.getMarketQuoteGroup(ctx, g)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch market quotes (%s): %w", g.fs, err)
+		}
+		quotes = append(quotes, groupQuotes...)
+	}
+	return quotes, nil
+}
+
+func (c *Client) getMarketQuoteGroup(ctx context.Context, g securityGroup) ([]*MarketQuote, error) {
+	var quotes []*MarketQuote
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("https://push2.eastmoney.com/api/qt/clist/get?ut=fa5fd1943c7b386f172d6893dbfba10b&pn=%d&pz=%d&po=0&np=1&fltt=2&invt=2&fid=f12&fs=%s&fields=f2,f3,f6,f12,f13,f14,f18",
+			page, securityPageSize, g.fs)
+
+		var result MarketQuoteResponse
+		if err := c.getJSON(ctx, url, &result); err != nil {
+			return nil, err
+		}
+		if result.Data == nil || len(result.Data.Diff) == 0 {
+			break
+		}
+
+		for _, d := range result.Data.Diff {
+			exchange := g.exchange
+			if exchange == "" {
+				exchange = "SZ"
+				if d.Market == 1 {
+					exchange = "SH"
+				}
+			}
+			quotes = append(quotes, &MarketQuote{
+				Symbol:        d.Code,
+				Exchange:      exchange,
+				Name:          strings.TrimSpace(d.Name),
+				Price:         float64(d.Price),
+				ChangePercent: float64(d.ChangePercent),
+				PrevClose:     float64(d.PrevClose),
+				Amount:        float64(d.Amount),
+			})
+		}
+
+		if page*securityPageSize >= result.Data.Total {
+			break
+		}
+	}
+	return quotes, nil
+}
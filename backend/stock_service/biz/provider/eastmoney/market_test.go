@@ -0,0 +1,23 @@
+package eastmoney
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketQuoteResponseSuspended(t *testing.T) {
+	body := `{"rc":0,"data":{"total":2,"diff":[
+		{"f2":1688.0,"f3":1.25,"f6":5.6e9,"f12":"600519","f13":1,"f14":"贵州茅台","f18":1667.16},
+		{"f2":"-","f3":"-","f6":"-","f12":"000001","f13":0,"f14":"平安银行","f18":11.2}
+	]}}`
+
+	var result MarketQuoteResponse
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	if assert.Len(t, result.Data.Diff, 2) {
+		assert.Equal(t, flexFloat(1688), result.Data.Diff[0].Price)
+		assert.Equal(t, flexFloat(0), result.Data.Diff[1].Price)
+		assert.Equal(t, flexFloat(11.2), result.Data.Diff[1].PrevClose)
+	}
+}
@@ -0,0 +1,279 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Northbound (沪深港通) Support ---
+
+// Datacenter MUTUAL_TYPE of the two northbound connects
+const (
+	mutualTypeSH = "001" // 沪股通
+	mutualTypeSZ = "003" // 深股通
+)
+
+// connectOf maps a MUTUAL_TYPE to the connect name used in the responses
+var connectOf = map[string]string{
+	mutualTypeSH: "sh",
+	mutualTypeSZ: "sz",
+}
+
+type NorthboundDealResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []struct {
+			MutualType string  `json:"MUTUAL_TYPE"`
+			TradeDate  string  `json:"TRADE_DATE"`   // "2024-05-15 00:00:00"
+			NetDealAmt float64 `json:"NET_DEAL_AMT"` // 百万元
+			BuyAmt     float64 `json:"BUY_AMT"`
+			SellAmt    float64 `json:"SELL_AMT"`
+			DealAmt    float64 `json:"DEAL_AMT"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetNorthboundDays fetches the daily northbound deals of the days sessions up to endDate
+// (YYYY-MM-DD, the latest when empty), oldest first
+func (c *Client) GetNorthboundDays(ctx context.Context, days int, endDate string) ([]*stock.NorthboundDay, error) {
+	filter := fmt.Sprintf("(MUTUAL_TYPE%%20in%%20(%%22%s%%22,%%22%s%%22))", mutualTypeSH, mutualTypeSZ)
+	if endDate != "" {
+		filter += fmt.Sprintf("(TRADE_DATE%%3C=%%27%s%%27)", endDate)
+	}
+	url := fmt.Sprintf("https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=RPT_MUTUAL_DEAL_HISTORY&columns=ALL&filter=%s&pageNumber=1&pageSize=%d&sortTypes=-1&sortColumns=TRADE_DATE",
+		filter, days*2)
+
+	var result NorthboundDealResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+	if !result.Success || result.Result == nil {
+		return nil, fmt.Errorf("no northbound deal data returned from eastmoney")
+	}
+
+	const million = 1e6
+	byDate := make(map[string]*stock.NorthboundDay)
+	for _, d := range result.Result.Data {
+		date := datePart(d.TradeDate)
+		day, ok := byDate[date]
+		if !ok {
+			day = &stock.NorthboundDay{Date: date}
+			byDate[date] = day
+		}
+		switch d.MutualType {
+		case mutualTypeSH:
+			day.ShNetBuy = d.NetDealAmt * million
+			day.ShDealAmount = d.DealAmt * million
+		case mutualTypeSZ:
+			day.SzNetBuy = d.NetDealAmt * million
+			day.SzDealAmount = d.DealAmt * million
+		}
+	}
+
+	list := make([]*stock.NorthboundDay, 0, len(byDate))
+	for _, day := range byDate {
+		day.NetBuy = day.ShNetBuy + day.SzNetBuy
+		day.DealAmount = day.ShDealAmount + day.SzDealAmount
+		list = append(list, day)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Date < list[j].Date })
+	if len(list) > days {
+		list = list[len(list)-days:]
+	}
+	return list, nil
+}
+
+type NorthboundMinuteResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		S2NDate string   `json:"s2nDate"` // "05-15"
+		S2N     []string `json:"s2n"`     // "9:31,sh net,sh quota left,sz net,sz quota left,total net" in 万元
+	} `json:"data"`
+}
+
+// GetNorthboundIntraday fetches the cumulative minute northbound net buy of the latest session.
+// today resolves the year of the MM-DD session date the endpoint returns.
+func (c *Client) GetNorthboundIntraday(ctx context.Context, today time.Time) (string, []*stock.NorthboundMinute, error) {
+	url := "https://push2.eastmoney.com/api/qt/kamt.rtmin/get?ut=b2884a393a59ad64002292a3e90d46a5&fields1=f1,f2,f3,f4&fields2=f51,f52,f53,f54,f55,f56"
+
+	var result NorthboundMinuteResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return "", nil, err
+	}
+	if result.Data == nil {
+		return "", nil, fmt.Errorf("no northbound intraday data returned from eastmoney")
+	}
+
+	points := make([]*stock.NorthboundMinute, 0, len(result.Data.S2N))
+	for _, line := range result.Data.S2N {
+		point, ok := parseNorthboundMinute(line)
+		if !ok {
+			continue
+		}
+		points = append(points, point)
+	}
+	return resolveMonthDay(result.Data.S2NDate, today), points, nil
+}
+
+// parseNorthboundMinute parses one s2n line, ok is false for the minutes not traded yet ("-")
+func parseNorthboundMinute(line string) (*stock.NorthboundMinute, bool) {
+	parts := strings.Split(line, ",")
+	if len(parts) < 6 {
+		return nil, false
+	}
+	const wan = 1e4
+	values := make([]float64, 0, 3)
+	for _, i := range []int{1, 3, 5} {
+		v, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, v*wan)
+	}
+
+	t := parts[0]
+	if len(t) == 4 { // "9:31"
+		t = "0" + t
+	}
+	return &stock.NorthboundMinute{
+		Time:     t,
+		ShNetBuy: values[0],
+		SzNetBuy: values[1],
+		NetBuy:   values[2],
+	}, true
+}
+
+// resolveMonthDay turns the MM-DD of a recent session into YYYY-MM-DD, a month-day
+// after today belongs to the previous year
+func resolveMonthDay(md string, today time.Time) string {
+	t, err := time.Parse("01-02", md)
+	if err != nil {
+		return ""
+	}
+	date := time.Date(today.Year(), t.Month(), t.Day(), 0, 0, 0, 0, today.Location())
+	if date.After(today) {
+		date = date.AddDate(-1, 0, 0)
+	}
+	return date.Format("2006-01-02")
+}
+
+type NorthboundTopResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []struct {
+			MutualType   string  `json:"MUTUAL_TYPE"`
+			TradeDate    string  `json:"TRADE_DATE"`
+			Rank         int32   `json:"RANK"`
+			SecurityCode string  `json:"SECURITY_CODE"`
+			SecurityName string  `json:"SECURITY_NAME"`
+			ClosePrice   float64 `json:"CLOSE_PRICE"`
+			ChangeRate   float64 `json:"CHANGE_RATE"`
+			NetBuyAmt    float64 `json:"NET_BUY_AMT"`
+			BuyAmt       float64 `json:"BUY_AMT"`
+			SellAmt      float64 `json:"SELL_AMT"`
+			DealAmt      float64 `json:"DEAL_AMT"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetNorthboundTopStocks fetches the top 10 traded stocks of each northbound connect on date (YYYY-MM-DD)
+func (c *Client) GetNorthboundTopStocks(ctx context.Context, date string) ([]*stock.NorthboundTopStock, error) {
+	url := fmt.Sprintf("https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=RPT_MUTUAL_TOP10DEAL&columns=ALL&filter=(TRADE_DATE=%%27%s%%27)(MUTUAL_TYPE%%20in%%20(%%22%s%%22,%%22%s%%22))&pageNumber=1&pageSize=20&sortTypes=1,1&sortColumns=MUTUAL_TYPE,RANK",
+		date, mutualTypeSH, mutualTypeSZ)
+
+	var result NorthboundTopResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, err
+	}
+	// The datacenter answers success=false when the day has no data
+	if result.Result == nil {
+		return nil, nil
+	}
+
+	stocks := make([]*stock.NorthboundTopStock, 0, len(result.Result.Data))
+	for _, d := range result.Result.Data {
+		stocks = append(stocks, &stock.NorthboundTopStock{
+			Connect:       connectOf[d.MutualType],
+			Rank:          d.Rank,
+			Code:          d.SecurityCode,
+			Name:          d.SecurityName,
+			Close:         d.ClosePrice,
+			ChangePercent: d.ChangeRate,
+			NetBuy:        d.NetBuyAmt,
+			BuyAmount:     d.BuyAmt,
+			SellAmount:    d.SellAmt,
+			DealAmount:    d.DealAmt,
+		})
+	}
+	return stocks, nil
+}
+
+type NorthboundHoldingResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []struct {
+			TradeDate       string  `json:"TRADE_DATE"`
+			SecurityName    string  `json:"SECURITY_NAME"`
+			HoldShares      float64 `json:"HOLD_SHARES"`
+			HoldMarketCap   float64 `json:"HOLD_MARKET_CAP"`
+			FreeSharesRatio float64 `json:"FREE_SHARES_RATIO"` // % of the float
+			ClosePrice      float64 `json:"CLOSE_PRICE"`
+			ChangeRate      float64 `json:"CHANGE_RATE"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetNorthboundHoldings fetches the daily northbound holding of one stock (6-digit code)
+// over the last days sessions, oldest first. Each day's change is against the day before it.
+func (c *Client) GetNorthboundHoldings(ctx context.Context, code string, days int) (string, []*stock.NorthboundHolding, error) {
+	// One extra day to compute the change of the oldest one
+	url := fmt.Sprintf("https://datacenter-web.eastmoney.com/api/data/v1/get?reportName=RPT_MUTUAL_HOLDSTOCKNORTH_STA&columns=ALL&filter=(SECURITY_CODE=%%22%s%%22)&pageNumber=1&pageSize=%d&sortTypes=-1&sortColumns=TRADE_DATE",
+		code, days+1)
+
+	var result NorthboundHoldingResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return "", nil, err
+	}
+	if result.Result == nil || len(result.Result.Data) == 0 {
+		return "", nil, fmt.Errorf("no northbound holding data for %s", code)
+	}
+
+	rows := result.Result.Data
+	name := rows[0].SecurityName
+	holdings := make([]*stock.NorthboundHolding, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		d := rows[i]
+		h := &stock.NorthboundHolding{
+			Date:          datePart(d.TradeDate),
+			Shares:        d.HoldShares,
+			MarketCap:     d.HoldMarketCap,
+			FloatRatio:    d.FreeSharesRatio,
+			Close:         d.ClosePrice,
+			ChangePercent: d.ChangeRate,
+		}
+		if i+1 < len(rows) {
+			prev := rows[i+1]
+			h.SharesChange = d.HoldShares - prev.HoldShares
+			h.MarketCapChange = d.HoldMarketCap - prev.HoldMarketCap
+		}
+		holdings = append(holdings, h)
+	}
+	if len(holdings) > days {
+		holdings = holdings[len(holdings)-days:]
+	}
+	return name, holdings, nil
+}
+
+// datePart trims the time of a datacenter "YYYY-MM-DD HH:MM:SS" date
+func datePart(s string) string {
+	if len(s) >= 10 {
+		return s[:10]
+	}
+	return s
+}
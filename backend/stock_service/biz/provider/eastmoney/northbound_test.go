@@ -0,0 +1,49 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNorthboundMinute(t *testing.T) {
+	point, ok := parseNorthboundMinute("9:31,12345.67,5187654.33,-2345.00,5202345.00,10000.67")
+	assert.True(t, ok)
+	assert.Equal(t, "09:31", point.Time)
+	assert.InDelta(t, 123456700.0, point.ShNetBuy, 1e-3)
+	assert.InDelta(t, -23450000.0, point.SzNetBuy, 1e-3)
+	assert.InDelta(t, 100006700.0, point.NetBuy, 1e-3)
+
+	// Minutes not traded yet
+	_, ok = parseNorthboundMinute("14:59,-,-,-,-,-")
+	assert.False(t, ok)
+	_, ok = parseNorthboundMinute("14:59")
+	assert.False(t, ok)
+}
+
+func TestResolveMonthDay(t *testing.T) {
+	today := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2024-05-15", resolveMonthDay("05-15", today))
+	assert.Equal(t, "2024-05-14", resolveMonthDay("05-14", today))
+
+	newYear := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2024-12-31", resolveMonthDay("12-31", newYear))
+
+	assert.Equal(t, "", resolveMonthDay("", today))
+}
+
+func TestGetNorthboundDays(t *testing.T) {
+	client := NewClient()
+	days, err := client.GetNorthboundDays(context.Background(), 5, "2024-05-10")
+	if err != nil {
+		t.Logf("Failed to get northbound deals: %v", err)
+		return
+	}
+	assert.LessOrEqual(t, len(days), 5)
+	for _, d := range days {
+		assert.LessOrEqual(t, d.Date, "2024-05-10")
+		t.Logf("%+v", d)
+	}
+}
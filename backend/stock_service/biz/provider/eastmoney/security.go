@@ -0,0 +1,122 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// --- Security List Support ---
+
+// securityPageSize is the clist page size, push2 caps larger values anyway
+const securityPageSize = 100
+
+type securityGroup struct {
+	fs       string
+	secType  string // stock, etf, index
+	exchange string // Forced exchange, empty means derive it from the market id
+}
+
+// securityGroups are the clist filters that together make up the A-share universe
+var securityGroups = []securityGroup{
+	{fs: "m:1+t:2,m:1+t:23", secType: "stock"},                  // SH main board + STAR
+	{fs: "m:0+t:6,m:0+t:80", secType: "stock"},                  // SZ main board + ChiNext
+	{fs: "m:0+t:81+s:2048", secType: "stock", exchange: "BJ"},   // BSE, reported with market id 0
+	{fs: "b:MK0021,b:MK0022,b:MK0023,b:MK0024", secType: "etf"}, // ETFs of both exchanges
+	{fs: "m:1+s:2,m:0+t:5", secType: "index"},                   // SH and SZ indices
+}
+
+type SecurityListResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Total int `json:"total"`
+		Diff  []struct {
+			Code        string      `json:"f12"`
+			Market      int         `json:"f13"`
+			Name        string      `json:"f14"`
+			ListingDate interface{} `json:"f26"` // YYYYMMDD number, "-" for indices
+		} `json:"diff"`
+	} `json:"data"`
+}
+
+type SecurityItem struct {
+	Symbol      string // 600519
+	Exchange    string // SH, SZ, BJ
+	Type        string // stock, etf, index
+	Name        string
+	ListingDate string // YYYY-MM-DD, empty when unknown
+}
+
+// GetSecurityList pages through clist and returns every listed stock, ETF and index.
+// complete is false when a group returned fewer items than the total clist reported,
+// e.g. when a page came back without data, so a caller must not treat missing codes as delisted.
+func (c *Client) GetSecurityList(ctx context.Context) (items []*SecurityItem, complete bool, err error) {
+	complete = true
+	for _, g := range securityGroups {
+		groupItems, total, err := c.getSecurityGroup(ctx, g)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to fetch %s list (%s): %w", g.secType, g.fs, err)
+		}
+		if total == 0 || len(groupItems) < total {
+			complete = false
+		}
+		items = append(items, groupItems...)
+	}
+	return items, complete, nil
+}
+
+// getSecurityGroup returns the items of g and the total clist reported for it, 0 when unknown
+func (c *Client) getSecurityGroup(ctx context.Context, g securityGroup) ([]*SecurityItem, int, error) {
+	var items []*SecurityItem
+	total := 0
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("https://push2.eastmoney.com/api/qt/clist/get?ut=fa5fd1943c7b386f172d6893dbfba10b&pn=%d&pz=%d&po=0&np=1&fltt=2&invt=2&fid=f12&fs=%s&fields=f12,f13,f14,f26",
+			page, securityPageSize, g.fs)
+
+		var result SecurityListResponse
+		if err := c.getJSON(ctx, url, &result); err != nil {
+			return nil, 0, err
+		}
+		if result.Data == nil || len(result.Data.Diff) == 0 {
+			break
+		}
+		total = result.Data.Total
+
+		for _, d := range result.Data.Diff {
+			exchange := g.exchange
+			if exchange == "" {
+				exchange = "SZ"
+				if d.Market == 1 {
+					exchange = "SH"
+				}
+			}
+			items = append(items, &SecurityItem{
+				Symbol:      d.Code,
+				Exchange:    exchange,
+				Type:        g.secType,
+				Name:        strings.TrimSpace(d.Name),
+				ListingDate: formatListingDate(d.ListingDate),
+			})
+		}
+
+		if page*securityPageSize >= result.Data.Total {
+			break
+		}
+	}
+	return items, total, nil
+}
+
+// formatListingDate converts the f26 value (20010827) to 2001-08-27
+func formatListingDate(v interface{}) string {
+	var s string
+	switch t := v.(type) {
+	case float64:
+		s = fmt.Sprintf("%.0f", t)
+	case string:
+		s = t
+	}
+	if len(s) != 8 {
+		return ""
+	}
+	return s[:4] + "-" + s[4:6] + "-" + s[6:]
+}
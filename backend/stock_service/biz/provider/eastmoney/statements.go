@@ -0,0 +1,196 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Financial Statements Support ---
+
+// incomeRow is one report of RPT_F10_FINANCE_GINCOME, amounts are year to date
+type incomeRow struct {
+	SecurityName       string  `json:"SECURITY_NAME_ABBR"`
+	ReportDate         string  `json:"REPORT_DATE"`
+	TotalOperateIncome float64 `json:"TOTAL_OPERATE_INCOME"`
+	OperateIncome      float64 `json:"OPERATE_INCOME"`
+	OperateCost        float64 `json:"OPERATE_COST"`
+	SaleExpense        float64 `json:"SALE_EXPENSE"`
+	ManageExpense      float64 `json:"MANAGE_EXPENSE"`
+	ResearchExpense    float64 `json:"RESEARCH_EXPENSE"`
+	FinanceExpense     float64 `json:"FINANCE_EXPENSE"`
+	OperateProfit      float64 `json:"OPERATE_PROFIT"`
+	TotalProfit        float64 `json:"TOTAL_PROFIT"`
+	IncomeTax          float64 `json:"INCOME_TAX"`
+	NetProfit          float64 `json:"NETPROFIT"`
+	ParentNetProfit    float64 `json:"PARENT_NETPROFIT"`
+	DeductNetProfit    float64 `json:"DEDUCT_PARENT_NETPROFIT"`
+	BasicEPS           float64 `json:"BASIC_EPS"`
+}
+
+// balanceRow is one report of RPT_F10_FINANCE_GBALANCE
+type balanceRow struct {
+	ReportDate         string  `json:"REPORT_DATE"`
+	TotalAssets        float64 `json:"TOTAL_ASSETS"`
+	TotalCurrentAssets float64 `json:"TOTAL_CURRENT_ASSETS"`
+	MonetaryFunds      float64 `json:"MONETARYFUNDS"`
+	AccountsRece       float64 `json:"ACCOUNTS_RECE"`
+	Inventory          float64 `json:"INVENTORY"`
+	FixedAsset         float64 `json:"FIXED_ASSET"`
+	Goodwill           float64 `json:"GOODWILL"`
+	TotalLiabilities   float64 `json:"TOTAL_LIABILITIES"`
+	TotalCurrentLiab   float64 `json:"TOTAL_CURRENT_LIAB"`
+	ShortLoan          float64 `json:"SHORT_LOAN"`
+	LongLoan           float64 `json:"LONG_LOAN"`
+	TotalEquity        float64 `json:"TOTAL_EQUITY"`
+	TotalParentEquity  float64 `json:"TOTAL_PARENT_EQUITY"`
+}
+
+// cashFlowRow is one report of RPT_F10_FINANCE_GCASHFLOW, amounts are year to date
+type cashFlowRow struct {
+	ReportDate         string  `json:"REPORT_DATE"`
+	NetCashOperate     float64 `json:"NETCASH_OPERATE"`
+	NetCashInvest      float64 `json:"NETCASH_INVEST"`
+	NetCashFinance     float64 `json:"NETCASH_FINANCE"`
+	ConstructLongAsset float64 `json:"CONSTRUCT_LONG_ASSET"`
+	CCEAdd             float64 `json:"CCE_ADD"`
+	EndCCE             float64 `json:"END_CCE"`
+}
+
+type IncomeStatementResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []incomeRow `json:"data"`
+	} `json:"result"`
+}
+
+type BalanceSheetResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []balanceRow `json:"data"`
+	} `json:"result"`
+}
+
+type CashFlowStatementResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Data []cashFlowRow `json:"data"`
+	} `json:"result"`
+}
+
+// reportTypes maps the month of a report date to the report it belongs to
+var reportTypes = map[string]string{
+	"03": "Q1",
+	"06": "H1",
+	"09": "Q3",
+	"12": "FY",
+}
+
+// GetFinancialStatements fetches the income statement, balance sheet and cash flow statement
+// of the last count reports of a general (non-financial) company, newest first, as published:
+// income and cash flow amounts are year to date. suffixed is the code in 600519.SH form.
+func (c *Client) GetFinancialStatements(ctx context.Context, suffixed string, count int) (string, []*stock.FinancialPeriod, error) {
+	var income IncomeStatementResponse
+	if err := c.getF10Report(ctx, "RPT_F10_FINANCE_GINCOME", "APP_F10_GINCOME", suffixed, count, &income); err != nil {
+		return "", nil, err
+	}
+	if income.Result == nil || len(income.Result.Data) == 0 {
+		return "", nil, fmt.Errorf("no financial statements for %s", suffixed)
+	}
+
+	// The balance sheet and the cash flow statement are optional, a missing one stays empty
+	var balance BalanceSheetResponse
+	if err := c.getF10Report(ctx, "RPT_F10_FINANCE_GBALANCE", "APP_F10_GBALANCE", suffixed, count, &balance); err != nil {
+		return "", nil, err
+	}
+	var cashFlow CashFlowStatementResponse
+	if err := c.getF10Report(ctx, "RPT_F10_FINANCE_GCASHFLOW", "APP_F10_GCASHFLOW", suffixed, count, &cashFlow); err != nil {
+		return "", nil, err
+	}
+
+	byDate := make(map[string]*stock.FinancialPeriod)
+	for _, r := range income.Result.Data {
+		date := datePart(r.ReportDate)
+		byDate[date] = &stock.FinancialPeriod{
+			ReportDate: date,
+			ReportType: reportTypes[monthOf(date)],
+			Income: &stock.IncomeStatement{
+				TotalRevenue:      r.TotalOperateIncome,
+				Revenue:           r.OperateIncome,
+				OperatingCost:     r.OperateCost,
+				SellingExpense:    r.SaleExpense,
+				AdminExpense:      r.ManageExpense,
+				RdExpense:         r.ResearchExpense,
+				FinanceExpense:    r.FinanceExpense,
+				OperatingProfit:   r.OperateProfit,
+				TotalProfit:       r.TotalProfit,
+				IncomeTax:         r.IncomeTax,
+				NetProfit:         r.NetProfit,
+				ParentNetProfit:   r.ParentNetProfit,
+				DeductedNetProfit: r.DeductNetProfit,
+				Eps:               r.BasicEPS,
+			},
+			Balance:  &stock.BalanceSheet{},
+			CashFlow: &stock.CashFlowStatement{},
+		}
+	}
+	if balance.Result != nil {
+		for _, r := range balance.Result.Data {
+			if p, ok := byDate[datePart(r.ReportDate)]; ok {
+				p.Balance = &stock.BalanceSheet{
+					TotalAssets:        r.TotalAssets,
+					CurrentAssets:      r.TotalCurrentAssets,
+					Cash:               r.MonetaryFunds,
+					Receivables:        r.AccountsRece,
+					Inventory:          r.Inventory,
+					FixedAssets:        r.FixedAsset,
+					Goodwill:           r.Goodwill,
+					TotalLiabilities:   r.TotalLiabilities,
+					CurrentLiabilities: r.TotalCurrentLiab,
+					ShortTermLoans:     r.ShortLoan,
+					LongTermLoans:      r.LongLoan,
+					TotalEquity:        r.TotalEquity,
+					ParentEquity:       r.TotalParentEquity,
+				}
+			}
+		}
+	}
+	if cashFlow.Result != nil {
+		for _, r := range cashFlow.Result.Data {
+			if p, ok := byDate[datePart(r.ReportDate)]; ok {
+				p.CashFlow = &stock.CashFlowStatement{
+					OperatingCashFlow: r.NetCashOperate,
+					InvestingCashFlow: r.NetCashInvest,
+					FinancingCashFlow: r.NetCashFinance,
+					Capex:             r.ConstructLongAsset,
+					NetCashChange:     r.CCEAdd,
+					EndingCash:        r.EndCCE,
+				}
+			}
+		}
+	}
+
+	periods := make([]*stock.FinancialPeriod, 0, len(byDate))
+	for _, p := range byDate {
+		periods = append(periods, p)
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].ReportDate > periods[j].ReportDate })
+	return income.Result.Data[0].SecurityName, periods, nil
+}
+
+// getF10Report fetches the newest count reports of one F10 statement report
+func (c *Client) getF10Report(ctx context.Context, reportName, style, suffixed string, count int, v interface{}) error {
+	url := fmt.Sprintf("https://datacenter.eastmoney.com/securities/api/data/get?type=%s&sty=%s&filter=(SECUCODE=%%22%s%%22)&p=1&ps=%d&sr=-1&st=REPORT_DATE&source=HSF10&client=PC",
+		reportName, style, suffixed, count)
+	return c.getJSON(ctx, url, v)
+}
+
+// monthOf returns the MM of a YYYY-MM-DD date
+func monthOf(date string) string {
+	if len(date) < 7 {
+		return ""
+	}
+	return date[5:7]
+}
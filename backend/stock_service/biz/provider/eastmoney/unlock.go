@@ -0,0 +1,54 @@
+package eastmoney
+
+import (
+	"context"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Restricted Share Unlock (限售解禁) Support ---
+
+type ShareUnlockResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Pages int `json:"pages"`
+		Data  []struct {
+			SecurityCode string    `json:"SECURITY_CODE"`
+			SecurityName string    `json:"SECURITY_NAME_ABBR"`
+			FreeDate     string    `json:"FREE_DATE"`
+			FreeShares   flexFloat `json:"CURRENT_FREE_SHARES"` // 万股
+			MarketCap    flexFloat `json:"LIFT_MARKET_CAP"`
+			FreeRatio    flexFloat `json:"FREE_RATIO"` // Fraction of the float
+			SharesType   string    `json:"FREE_SHARES_TYPE"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// GetShareUnlocks fetches the restricted share unlocks dated within [from, to], of one stock
+// (6-digit code) or of the market. Upcoming ones are valued at the latest close.
+func (c *Client) GetShareUnlocks(ctx context.Context, code, from, to string) ([]*stock.ShareUnlock, error) {
+	var list []*stock.ShareUnlock
+	err := getReportPages("RPT_LIFT_STAGE", dateRangeFilter(code, "FREE_DATE", from, to), "FREE_DATE",
+		func(url string) (int, error) {
+			var result ShareUnlockResponse
+			if err := c.getJSON(ctx, url, &result); err != nil {
+				return 0, err
+			}
+			if result.Result == nil {
+				return 0, nil
+			}
+			for _, d := range result.Result.Data {
+				list = append(list, &stock.ShareUnlock{
+					Code:       d.SecurityCode,
+					Name:       d.SecurityName,
+					Date:       datePart(d.FreeDate),
+					Shares:     float64(d.FreeShares) * 1e4,
+					MarketCap:  float64(d.MarketCap),
+					FloatRatio: float64(d.FreeRatio) * 100,
+					HolderType: d.SharesType,
+				})
+			}
+			return result.Result.Pages, nil
+		})
+	return list, err
+}
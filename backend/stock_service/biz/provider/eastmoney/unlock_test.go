@@ -0,0 +1,22 @@
+package eastmoney
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetShareUnlocks(t *testing.T) {
+	client := NewClient()
+	unlocks, err := client.GetShareUnlocks(context.Background(), "", "2024-04-01", "2024-04-07")
+	if err != nil {
+		t.Logf("Failed to get share unlocks: %v", err)
+		return
+	}
+	for _, u := range unlocks {
+		assert.GreaterOrEqual(t, u.Date, "2024-04-01")
+		assert.LessOrEqual(t, u.Date, "2024-04-07")
+		t.Logf("%+v", u)
+	}
+}
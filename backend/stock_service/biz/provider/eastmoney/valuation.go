@@ -0,0 +1,107 @@
+package eastmoney
+
+import (
+	"context"
+	"fmt"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// --- Valuation (估值分析) Support ---
+
+// valuationRow is one session of RPT_VALUEANALYSIS_DET
+type valuationRow struct {
+	TradeDate      string    `json:"TRADE_DATE"`
+	SecurityCode   string    `json:"SECURITY_CODE"`
+	SecurityName   string    `json:"SECURITY_NAME_ABBR"`
+	BoardCode      string    `json:"ORIG_BOARD_CODE"` // Eastmoney industry
+	BoardName      string    `json:"BOARD_NAME"`
+	ClosePrice     flexFloat `json:"CLOSE_PRICE"`
+	PETTM          flexFloat `json:"PE_TTM"`
+	PBMRQ          flexFloat `json:"PB_MRQ"`
+	PSTTM          flexFloat `json:"PS_TTM"`
+	TotalMarketCap flexFloat `json:"TOTAL_MARKET_CAP"`
+}
+
+func (r valuationRow) toThrift() *stock.ValuationDay {
+	return &stock.ValuationDay{
+		Date:      datePart(r.TradeDate),
+		Close:     float64(r.ClosePrice),
+		PeTtm:     float64(r.PETTM),
+		Pb:        float64(r.PBMRQ),
+		PsTtm:     float64(r.PSTTM),
+		MarketCap: float64(r.TotalMarketCap),
+	}
+}
+
+type ValuationResponse struct {
+	Success bool `json:"success"`
+	Result  *struct {
+		Pages int            `json:"pages"`
+		Data  []valuationRow `json:"data"`
+	} `json:"result"`
+}
+
+// StockValuation is the valuation history of one stock and the industry it belongs to
+type StockValuation struct {
+	Name         string
+	Industry     string
+	IndustryCode string
+	Days         []*stock.ValuationDay // Oldest first
+}
+
+// GetStockValuation fetches the daily valuation of one stock (6-digit code) since startDate (YYYY-MM-DD)
+func (c *Client) GetStockValuation(ctx context.Context, code, startDate string) (*StockValuation, error) {
+	rows, err := c.getValuationRows(ctx, dateRangeFilter(code, "TRADE_DATE", startDate, ""))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no valuation data for %s", code)
+	}
+
+	latest := rows[len(rows)-1]
+	result := &StockValuation{
+		Name:         latest.SecurityName,
+		Industry:     latest.BoardName,
+		IndustryCode: latest.BoardCode,
+		Days:         make([]*stock.ValuationDay, 0, len(rows)),
+	}
+	for _, r := range rows {
+		result.Days = append(result.Days, r.toThrift())
+	}
+	return result, nil
+}
+
+// GetIndustryValuation fetches the valuation of every stock of an industry (ORIG_BOARD_CODE) on date
+func (c *Client) GetIndustryValuation(ctx context.Context, industryCode, date string) ([]*stock.ValuationDay, error) {
+	filter := fmt.Sprintf("(ORIG_BOARD_CODE=%%22%s%%22)", industryCode) + dateRangeFilter("", "TRADE_DATE", date, date)
+	rows, err := c.getValuationRows(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*stock.ValuationDay, 0, len(rows))
+	for _, r := range rows {
+		list = append(list, r.toThrift())
+	}
+	return list, nil
+}
+
+// getValuationRows pages through RPT_VALUEANALYSIS_DET, oldest first
+func (c *Client) getValuationRows(ctx context.Context, filter string) ([]valuationRow, error) {
+	var rows []valuationRow
+	err := getReportPages("RPT_VALUEANALYSIS_DET", filter, "TRADE_DATE", func(url string) (int, error) {
+		var result ValuationResponse
+		if err := c.getJSON(ctx, url, &result); err != nil {
+			return 0, err
+		}
+		// The datacenter answers success=false when nothing matches
+		if result.Result == nil {
+			return 0, nil
+		}
+		rows = append(rows, result.Result.Data...)
+		return result.Result.Pages, nil
+	})
+	return rows, err
+}
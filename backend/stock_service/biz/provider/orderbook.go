@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"math"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// SetOrderBook attaches the five-level book to info and derives 委比/委差 from it.
+// Levels must be ordered best price first and volumes expressed in shares.
+func SetOrderBook(info *stock.StockInfo, bids, asks []*stock.OrderBookLevel) {
+	info.OrderBook = &stock.OrderBook{Bids: bids, Asks: asks}
+
+	var bidVol, askVol int64
+	for _, l := range bids {
+		bidVol += l.Volume
+	}
+	for _, l := range asks {
+		askVol += l.Volume
+	}
+
+	info.WeiCha = bidVol - askVol
+	info.WeiBi = 0
+	if total := bidVol + askVol; total > 0 {
+		// Rounded to 2 decimals like the exchange terminals display it
+		info.WeiBi = math.Round(float64(bidVol-askVol)/float64(total)*10000) / 100
+	}
+}
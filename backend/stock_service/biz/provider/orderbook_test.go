@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOrderBook(t *testing.T) {
+	info := &stock.StockInfo{}
+	SetOrderBook(info,
+		[]*stock.OrderBookLevel{{Price: 10.0, Volume: 3000}, {Price: 9.99, Volume: 1000}},
+		[]*stock.OrderBookLevel{{Price: 10.01, Volume: 1000}},
+	)
+	assert.Len(t, info.OrderBook.Bids, 2)
+	assert.Equal(t, int64(3000), info.WeiCha)
+	assert.Equal(t, 60.0, info.WeiBi)
+
+	// An empty book (suspended, limit board without sellers...) must not divide by zero
+	SetOrderBook(info, nil, nil)
+	assert.Equal(t, int64(0), info.WeiCha)
+	assert.Equal(t, 0.0, info.WeiBi)
+}
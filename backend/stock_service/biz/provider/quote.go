@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// QuoteProvider is a realtime quote feed (Sina, EastMoney, Tencent...).
+type QuoteProvider interface {
+	// Name identifies the feed, it is reported back in StockInfo.Source
+	Name() string
+	// GetStockInfo fetches the realtime quote for a single code
+	GetStockInfo(ctx context.Context, code string) (*stock.StockInfo, error)
+}
+
+// BatchQuoteProvider is a feed that can answer several codes in one round trip.
+// The returned map is keyed by the requested code; codes missing from it could
+// not be resolved. A non-nil error means the whole request failed.
+type BatchQuoteProvider interface {
+	QuoteProvider
+	GetStockInfos(ctx context.Context, codes []string) (map[string]*stock.StockInfo, error)
+}
+
+const (
+	// After this many consecutive failures a provider is put on cooldown
+	defaultMaxFailures = 3
+	// How long an unhealthy provider is skipped before we probe it again
+	defaultCooldown = 30 * time.Second
+)
+
+// ProviderHealth is a snapshot of the health state of one provider in the chain
+type ProviderHealth struct {
+	Name                string
+	Healthy             bool
+	ConsecutiveFailures int
+	LastError           string
+	LastSuccess         time.Time
+	LastFailure         time.Time
+}
+
+type providerState struct {
+	provider            QuoteProvider
+	consecutiveFailures int
+	lastError           string
+	lastSuccess         time.Time
+	lastFailure         time.Time
+	downUntil           time.Time
+}
+
+// QuoteChain tries its providers in order and fails over to the next one on error.
+// Providers that keep failing are skipped for a cooldown period so a blocked feed
+// does not add its timeout to every request.
+type QuoteChain struct {
+	mu          sync.Mutex
+	states      []*providerState
+	maxFailures int
+	cooldown    time.Duration
+	now         func() time.Time
+}
+
+// NewQuoteChain creates a chain, providers are tried in the given order
+func NewQuoteChain(providers ...QuoteProvider) *QuoteChain {
+	states := make([]*providerState, 0, len(providers))
+	for _, p := range providers {
+		states = append(states, &providerState{provider: p})
+	}
+	return &QuoteChain{
+		states:      states,
+		maxFailures: defaultMaxFailures,
+		cooldown:    defaultCooldown,
+		now:         time.Now,
+	}
+}
+
+// GetStockInfo returns the quote from the first provider that answers.
+// The returned StockInfo.Source is set to the name of that provider.
+func (c *QuoteChain) GetStockInfo(ctx context.Context, code string) (*stock.StockInfo, error) {
+	candidates := c.candidates()
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no quote provider configured")
+	}
+
+	var errs []string
+	for _, st := range candidates {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		info, err := st.provider.GetStockInfo(ctx, code)
+		if err == nil && info == nil {
+			err = errors.New("empty quote")
+		}
+		if err != nil {
+			// A cancelled request says nothing about the health of the feed
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			c.markFailure(st, err)
+			log.Printf("Quote provider %s failed for %s: %v", st.provider.Name(), code, err)
+			errs = append(errs, fmt.Sprintf("%s: %v", st.provider.Name(), err))
+			continue
+		}
+
+		c.markSuccess(st)
+		info.Source = st.provider.Name()
+		return info, nil
+	}
+
+	return nil, fmt.Errorf("all quote providers failed for %s: %s", code, strings.Join(errs, "; "))
+}
+
+// GetStockInfos resolves a batch of codes. Each provider in the chain only gets the
+// codes that previous providers could not answer. Codes nobody could resolve are
+// reported in the returned error map instead of failing the whole batch.
+func (c *QuoteChain) GetStockInfos(ctx context.Context, codes []string) (map[string]*stock.StockInfo, map[string]error) {
+	infos := make(map[string]*stock.StockInfo, len(codes))
+	errs := make(map[string]error)
+	// Per-code failure reasons, one entry per provider that was tried
+	reasons := make(map[string][]string)
+
+	remaining := codes
+	for _, st := range c.candidates() {
+		if len(remaining) == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			break
+		}
+
+		name := st.provider.Name()
+		answered := 0
+		var lastErr error
+
+		if bp, ok := st.provider.(BatchQuoteProvider); ok {
+			res, err := bp.GetStockInfos(ctx, remaining)
+			if err != nil {
+				lastErr = err
+				for _, code := range remaining {
+					reasons[code] = append(reasons[code], fmt.Sprintf("%s: %v", name, err))
+				}
+			}
+			for _, code := range remaining {
+				info := res[code]
+				if info == nil {
+					if err == nil {
+						reasons[code] = append(reasons[code], fmt.Sprintf("%s: no data", name))
+					}
+					continue
+				}
+				info.Source = name
+				infos[code] = info
+				answered++
+			}
+		} else {
+			for _, code := range remaining {
+				info, err := st.provider.GetStockInfo(ctx, code)
+				if err == nil && info == nil {
+					err = errors.New("empty quote")
+				}
+				if err != nil {
+					lastErr = err
+					reasons[code] = append(reasons[code], fmt.Sprintf("%s: %v", name, err))
+					continue
+				}
+				info.Source = name
+				infos[code] = info
+				answered++
+			}
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+		// One unknown code must not put a healthy feed on cooldown,
+		// only count it as a failure when nothing came back at all.
+		if answered == 0 {
+			if lastErr == nil {
+				lastErr = errors.New("no quotes returned")
+			}
+			c.markFailure(st, lastErr)
+			log.Printf("Quote provider %s failed for batch of %d codes: %v", name, len(remaining), lastErr)
+		} else {
+			c.markSuccess(st)
+		}
+
+		var next []string
+		for _, code := range remaining {
+			if _, ok := infos[code]; !ok {
+				next = append(next, code)
+			}
+		}
+		remaining = next
+	}
+
+	for _, code := range remaining {
+		switch {
+		case ctx.Err() != nil:
+			errs[code] = ctx.Err()
+		case len(reasons[code]) > 0:
+			errs[code] = fmt.Errorf("all quote providers failed for %s: %s", code, strings.Join(reasons[code], "; "))
+		default:
+			errs[code] = fmt.Errorf("no quote returned for %s", code)
+		}
+	}
+	return infos, errs
+}
+
+// Health returns the current health state of every provider, in chain order
+func (c *QuoteChain) Health() []ProviderHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	res := make([]ProviderHealth, 0, len(c.states))
+	for _, st := range c.states {
+		res = append(res, ProviderHealth{
+			Name:                st.provider.Name(),
+			Healthy:             !now.Before(st.downUntil),
+			ConsecutiveFailures: st.consecutiveFailures,
+			LastError:           st.lastError,
+			LastSuccess:         st.lastSuccess,
+			LastFailure:         st.lastFailure,
+		})
+	}
+	return res
+}
+
+// candidates returns healthy providers first (in chain order), followed by the
+// ones on cooldown, so we still try everything when the whole chain is down.
+func (c *QuoteChain) candidates() []*providerState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	var healthy, cooling []*providerState
+	for _, st := range c.states {
+		if now.Before(st.downUntil) {
+			cooling = append(cooling, st)
+		} else {
+			healthy = append(healthy, st)
+		}
+	}
+	return append(healthy, cooling...)
+}
+
+func (c *QuoteChain) markSuccess(st *providerState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	st.consecutiveFailures = 0
+	st.lastError = ""
+	st.lastSuccess = c.now()
+	st.downUntil = time.Time{}
+}
+
+func (c *QuoteChain) markFailure(st *providerState, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	st.consecutiveFailures++
+	st.lastError = err.Error()
+	st.lastFailure = now
+	if st.consecutiveFailures >= c.maxFailures {
+		st.downUntil = now.Add(c.cooldown)
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeProvider struct {
+	name  string
+	err   error
+	calls int
+}
+
+func (f *fakeProvider) Name() string {
+	return f.name
+}
+
+func (f *fakeProvider) GetStockInfo(ctx context.Context, code string) (*stock.StockInfo, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &stock.StockInfo{Code: code, Name: f.name}, nil
+}
+
+func TestQuoteChainFailover(t *testing.T) {
+	primary := &fakeProvider{name: "sina", err: errors.New("403 forbidden")}
+	backup := &fakeProvider{name: "eastmoney"}
+	chain := NewQuoteChain(primary, backup)
+
+	info, err := chain.GetStockInfo(context.Background(), "sh600519")
+	assert.Nil(t, err)
+	assert.Equal(t, "eastmoney", info.Source)
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, 1, backup.calls)
+}
+
+func TestQuoteChainCooldown(t *testing.T) {
+	now := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	primary := &fakeProvider{name: "sina", err: errors.New("timeout")}
+	backup := &fakeProvider{name: "tencent"}
+	chain := NewQuoteChain(primary, backup)
+	chain.now = func() time.Time { return now }
+
+	for i := 0; i < defaultMaxFailures; i++ {
+		_, err := chain.GetStockInfo(context.Background(), "sh600519")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, defaultMaxFailures, primary.calls)
+	assert.False(t, chain.Health()[0].Healthy)
+
+	// While cooling down the failing provider is tried last, so the backup answers directly
+	info, err := chain.GetStockInfo(context.Background(), "sh600519")
+	assert.Nil(t, err)
+	assert.Equal(t, "tencent", info.Source)
+	assert.Equal(t, defaultMaxFailures, primary.calls)
+
+	// After the cooldown the provider is probed again and recovers on success
+	now = now.Add(defaultCooldown)
+	primary.err = nil
+	info, err = chain.GetStockInfo(context.Background(), "sh600519")
+	assert.Nil(t, err)
+	assert.Equal(t, "sina", info.Source)
+	assert.True(t, chain.Health()[0].Healthy)
+	assert.Equal(t, 0, chain.Health()[0].ConsecutiveFailures)
+}
+
+func TestQuoteChainAllFailed(t *testing.T) {
+	chain := NewQuoteChain(
+		&fakeProvider{name: "sina", err: errors.New("blocked")},
+		&fakeProvider{name: "tencent", err: errors.New("bad gateway")},
+	)
+
+	_, err := chain.GetStockInfo(context.Background(), "sh600519")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "sina: blocked")
+	assert.Contains(t, err.Error(), "tencent: bad gateway")
+}
+
+// fakeBatchProvider only knows the codes in its map
+type fakeBatchProvider struct {
+	fakeProvider
+	known      map[string]bool
+	batchCalls [][]string
+}
+
+func (f *fakeBatchProvider) GetStockInfos(ctx context.Context, codes []string) (map[string]*stock.StockInfo, error) {
+	f.batchCalls = append(f.batchCalls, codes)
+	if f.err != nil {
+		return nil, f.err
+	}
+	res := make(map[string]*stock.StockInfo)
+	for _, code := range codes {
+		if f.known[code] {
+			res[code] = &stock.StockInfo{Code: code, Name: f.name}
+		}
+	}
+	return res, nil
+}
+
+func TestQuoteChainBatch(t *testing.T) {
+	primary := &fakeBatchProvider{
+		fakeProvider: fakeProvider{name: "sina"},
+		known:        map[string]bool{"sh600519": true, "sz000001": true},
+	}
+	backup := &fakeProvider{name: "tencent"}
+	chain := NewQuoteChain(primary, backup)
+
+	infos, errs := chain.GetStockInfos(context.Background(), []string{"sh600519", "sz000001", "sz300750"})
+	assert.Empty(t, errs)
+	assert.Equal(t, "sina", infos["sh600519"].Source)
+	assert.Equal(t, "sina", infos["sz000001"].Source)
+	// Only the code the batch feed missed is sent down the chain
+	assert.Equal(t, "tencent", infos["sz300750"].Source)
+	assert.Equal(t, 1, backup.calls)
+	// A partial answer keeps the provider healthy
+	assert.Equal(t, 0, chain.Health()[0].ConsecutiveFailures)
+}
+
+func TestQuoteChainBatchPerCodeErrors(t *testing.T) {
+	primary := &fakeBatchProvider{fakeProvider: fakeProvider{name: "sina", err: errors.New("blocked")}}
+	backup := &fakeBatchProvider{
+		fakeProvider: fakeProvider{name: "eastmoney"},
+		known:        map[string]bool{"sh600519": true},
+	}
+	chain := NewQuoteChain(primary, backup)
+
+	infos, errs := chain.GetStockInfos(context.Background(), []string{"sh600519", "sh000000"})
+	assert.Len(t, infos, 1)
+	assert.Equal(t, "eastmoney", infos["sh600519"].Source)
+	if assert.Contains(t, errs, "sh000000") {
+		assert.Contains(t, errs["sh000000"].Error(), "sina: blocked")
+		assert.Contains(t, errs["sh000000"].Error(), "eastmoney: no data")
+	}
+	assert.Equal(t, 1, chain.Health()[0].ConsecutiveFailures)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -26,82 +27,111 @@ type LimitUpStock struct {
 	Name          string  `json:"name"`
 	Price         float64 `json:"price"`
 	ChangePercent float64 `json:"change_percent"`
-	LimitUpType   string  `json:"limit_up_type"` // e.g., "首板", "2连板"
-	Reason        string  `json:"reason"`        // e.g., "华为概念"
-	IsBroken      bool    `json:"is_broken"`     // True if broken limit up (炸板)
+	LimitUpType   string  `json:"limit_up_type"`   // e.g., "首板", "2连板"
+	Reason        string  `json:"reason"`          // Industry board, e.g. "半导体"
+	IsBroken      bool    `json:"is_broken"`       // True if the seal opened at least once (炸板)
+	BoardCount    int     `json:"board_count"`     // 连板数, 1 for 首板
+	FirstSealTime string  `json:"first_seal_time"` // HH:MM:SS
+	LastSealTime  string  `json:"last_seal_time"`  // HH:MM:SS
+	SealAmount    float64 `json:"seal_amount"`     // 封单额 (CNY)
+	OpenCount     int     `json:"open_count"`      // 炸板次数
+	TurnoverRate  float64 `json:"turnover_rate"`   // 换手率 (%)
 }
 
 type LimitUpPoolResponse struct {
 	Rc   int `json:"rc"`
 	Data *struct {
 		Pool []struct {
-			Code       string  `json:"c"`
-			Name       string  `json:"n"`
-			Price      float64 `json:"p"`
-			ChangePct  float64 `json:"zdp"`
-			LimitUpTyp string  `json:"lbc"` // Limit Board Count, e.g. "1", "2"
-			Reason     string  `json:"hybk"` // Industry/Concept
-			IsBroken   int     `json:"zbc"`  // 0 or >0
-		} `json:"pool"` // NOTE: This structure is hypothetical based on common EM patterns, needs adjustment
+			Code          string  `json:"c"`
+			Name          string  `json:"n"`
+			Price         float64 `json:"p"` // In 厘 (0.001 CNY)
+			ChangePct     float64 `json:"zdp"`
+			TurnoverRate  float64 `json:"hs"`
+			BoardCount    int     `json:"lbc"`  // 连板数
+			FirstSealTime int     `json:"fbt"`  // HHMMSS
+			LastSealTime  int     `json:"lbt"`  // HHMMSS
+			SealAmount    float64 `json:"fund"` // 封单资金
+			OpenCount     int     `json:"zbc"`  // 炸板次数
+			Reason        string  `json:"hybk"` // Industry
+		} `json:"pool"`
 	} `json:"data"`
 }
 
-// GetLimitUpPool fetches the daily limit-up pool.
-// Note: This uses a reverse-engineered API which might be unstable.
-func (c *Client) GetLimitUpPool(ctx context.Context) ([]*LimitUpStock, error) {
-	// Using a placeholder URL that was found in search results, but might need date param.
-	// Current strategy: Try to fetch, if fail, return empty list (non-blocking).
-	// Real URL often looks like: https://push2ex.eastmoney.com/getTopicZTPool
-	
-	// Construct today's date in YYYYMMDD format
-	dateStr := time.Now().Format("20060102")
-	url := fmt.Sprintf("https://push2ex.eastmoney.com/getTopicZTPool?ut=7eea3edcaed734bea9cbfc24409ed989&dpt=wz.ztgc&Pageindex=0&pagesize=100&sort=fbt:asc&date=%s", dateStr)
-
+// getJSON fetches url and decodes the JSON body into v
+func (c *Client) getJSON(ctx context.Context, url string, v interface{}) error {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return json.Unmarshal(body, v)
+}
+
+// GetLimitUpPool fetches the limit-up pool (涨停池) of date (YYYY-MM-DD).
+// EastMoney only keeps the pools of roughly the last month of sessions, older
+// dates and non-trading days come back empty.
+func (c *Client) GetLimitUpPool(ctx context.Context, date string) ([]*LimitUpStock, error) {
+	// The API wants YYYYMMDD; pagesize is large enough for the busiest days
+	dateStr := strings.ReplaceAll(date, "-", "")
+	url := fmt.Sprintf("https://push2ex.eastmoney.com/getTopicZTPool?ut=7eea3edcaed734bea9cbfc24409ed989&dpt=wz.ztgc&Pageindex=0&pagesize=10000&sort=fbt:asc&date=%s", dateStr)
 
-	// For now, since we haven't confirmed the exact JSON structure of the ZTPool API,
-	// we will log the body (in a real app) and return a mock/empty list if parsing fails.
-	// This allows the compilation to succeed and we can refine the parsing logic later 
-	// when we have a valid response sample.
-	
 	var result LimitUpPoolResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		// Fallback: return error or empty
+	if err := c.getJSON(ctx, url, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse sentiment data: %v", err)
 	}
-	
+	return parseLimitUpPool(&result), nil
+}
+
+// parseLimitUpPool converts the raw ZT pool, a missing pool (rc != 0, which is
+// also what the API answers for dates without data) gives an empty list
+func parseLimitUpPool(result *LimitUpPoolResponse) []*LimitUpStock {
 	if result.Data == nil || result.Rc != 0 {
-		// Log the error but return empty list instead of misleading mock data
-		fmt.Printf("Warning: Sentiment API failed (rc=%d), returning empty list.\n", result.Rc)
-		return []*LimitUpStock{}, nil
+		return []*LimitUpStock{}
 	}
 
-	var stocks []*LimitUpStock
+	stocks := make([]*LimitUpStock, 0, len(result.Data.Pool))
 	for _, item := range result.Data.Pool {
 		stocks = append(stocks, &LimitUpStock{
 			Code:          item.Code,
 			Name:          item.Name,
-			Price:         item.Price,
+			Price:         item.Price / 1000,
 			ChangePercent: item.ChangePct,
-			LimitUpType:   fmt.Sprintf("%s连板", item.LimitUpTyp),
+			LimitUpType:   BoardLabel(item.BoardCount),
 			Reason:        item.Reason,
-			IsBroken:      item.IsBroken > 0,
+			IsBroken:      item.OpenCount > 0,
+			BoardCount:    item.BoardCount,
+			FirstSealTime: formatClock(item.FirstSealTime),
+			LastSealTime:  formatClock(item.LastSealTime),
+			SealAmount:    item.SealAmount,
+			OpenCount:     item.OpenCount,
+			TurnoverRate:  item.TurnoverRate,
 		})
 	}
+	return stocks
+}
 
-	return stocks, nil
+// BoardLabel renders a continuous board count the way traders say it: "首板", "2连板"...
+func BoardLabel(boards int) string {
+	if boards <= 1 {
+		return "首板"
+	}
+	return fmt.Sprintf("%d连板", boards)
+}
+
+// formatClock renders an HHMMSS integer (92500) as 09:25:00, empty when unset
+func formatClock(hms int) string {
+	if hms <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", hms/10000, hms/100%100, hms%100)
 }
@@ -2,14 +2,48 @@ package sentiment
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
+	"stock_assistant/backend/common/calendar"
+
 	"github.com/stretchr/testify/assert"
 )
 
+func TestParseLimitUpPool(t *testing.T) {
+	body := `{"rc":0,"data":{"tc":2,"qdate":20240515,"pool":[
+		{"c":"000890","m":0,"n":"法尔胜","p":3980,"zdp":10.0,"amount":123456789,"hs":5.31,"lbc":1,"fbt":92500,"lbt":135512,"fund":45678900,"zbc":2,"hybk":"通用设备","zttj":{"days":1,"ct":1}},
+		{"c":"600000","m":1,"n":"某某股份","p":12710,"zdp":10.04,"hs":1.2,"lbc":3,"fbt":93000,"lbt":93000,"fund":1000000,"zbc":0,"hybk":"银行"}
+	]}}`
+
+	var result LimitUpPoolResponse
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	pool := parseLimitUpPool(&result)
+	if assert.Len(t, pool, 2) {
+		first := pool[0]
+		assert.Equal(t, "000890", first.Code)
+		assert.Equal(t, 3.98, first.Price)
+		assert.Equal(t, "首板", first.LimitUpType)
+		assert.Equal(t, 1, first.BoardCount)
+		assert.Equal(t, "09:25:00", first.FirstSealTime)
+		assert.Equal(t, "13:55:12", first.LastSealTime)
+		assert.Equal(t, 45678900.0, first.SealAmount)
+		assert.Equal(t, 2, first.OpenCount)
+		assert.True(t, first.IsBroken)
+		assert.Equal(t, 5.31, first.TurnoverRate)
+
+		assert.Equal(t, "3连板", pool[1].LimitUpType)
+		assert.False(t, pool[1].IsBroken)
+	}
+
+	// Dates without a pool answer rc != 0 and no data
+	assert.Nil(t, json.Unmarshal([]byte(`{"rc":102,"data":null}`), &result))
+	assert.Empty(t, parseLimitUpPool(&result))
+}
+
 func TestGetLimitUpPool(t *testing.T) {
 	client := NewClient()
-	pool, err := client.GetLimitUpPool(context.Background())
+	pool, err := client.GetLimitUpPool(context.Background(), calendar.DefaultDate(""))
 	if err != nil {
 		t.Logf("Failed to get limit up pool (might be expected if API changed): %v", err)
 		// Allow failure for now as API is unstable
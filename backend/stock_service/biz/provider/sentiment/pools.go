@@ -0,0 +1,176 @@
+package sentiment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// BrokenLimitStock is a stock that touched limit-up during the day but did not close there (炸板)
+type BrokenLimitStock struct {
+	Code          string  `json:"code"`
+	Name          string  `json:"name"`
+	Price         float64 `json:"price"`
+	ChangePercent float64 `json:"change_percent"`
+	LimitUpPrice  float64 `json:"limit_up_price"`  // 涨停价
+	HighestChange float64 `json:"highest_change"`  // Change at the limit price, i.e. the day's high (%)
+	FirstSealTime string  `json:"first_seal_time"` // HH:MM:SS; the feed has no time for the break itself
+	OpenCount     int     `json:"open_count"`      // 炸板次数
+	Amount        float64 `json:"amount"`          // CNY
+	TurnoverRate  float64 `json:"turnover_rate"`   // 换手率 (%)
+	Amplitude     float64 `json:"amplitude"`       // 振幅 (%)
+	Reason        string  `json:"reason"`          // Industry
+}
+
+// LimitDownStock is a stock sealed at limit-down (跌停)
+type LimitDownStock struct {
+	Code           string  `json:"code"`
+	Name           string  `json:"name"`
+	Price          float64 `json:"price"`
+	ChangePercent  float64 `json:"change_percent"`
+	SealAmount     float64 `json:"seal_amount"`     // 封单额 (CNY)
+	LastSealTime   string  `json:"last_seal_time"`  // HH:MM:SS
+	BoardAmount    float64 `json:"board_amount"`    // 板上成交额 (CNY)
+	ContinuousDays int     `json:"continuous_days"` // 连续跌停天数
+	OpenCount      int     `json:"open_count"`      // 开板次数
+	Amount         float64 `json:"amount"`          // CNY
+	TurnoverRate   float64 `json:"turnover_rate"`   // 换手率 (%)
+	Reason         string  `json:"reason"`          // Industry
+}
+
+type BrokenLimitPoolResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Pool []struct {
+			Code          string  `json:"c"`
+			Name          string  `json:"n"`
+			Price         float64 `json:"p"`   // In 厘 (0.001 CNY)
+			LimitUpPrice  float64 `json:"ztp"` // In 厘
+			ChangePct     float64 `json:"zdp"`
+			Amount        float64 `json:"amount"`
+			TurnoverRate  float64 `json:"hs"`
+			FirstSealTime int     `json:"fbt"` // HHMMSS
+			OpenCount     int     `json:"zbc"`
+			Amplitude     float64 `json:"zf"`
+			Reason        string  `json:"hybk"`
+		} `json:"pool"`
+	} `json:"data"`
+}
+
+type LimitDownPoolResponse struct {
+	Rc   int `json:"rc"`
+	Data *struct {
+		Pool []struct {
+			Code           string  `json:"c"`
+			Name           string  `json:"n"`
+			Price          float64 `json:"p"` // In 厘 (0.001 CNY)
+			ChangePct      float64 `json:"zdp"`
+			Amount         float64 `json:"amount"`
+			TurnoverRate   float64 `json:"hs"`
+			SealAmount     float64 `json:"fund"`
+			LastSealTime   int     `json:"lbt"` // HHMMSS
+			BoardAmount    float64 `json:"fba"`
+			ContinuousDays int     `json:"days"`
+			OpenCount      int     `json:"oc"`
+			Reason         string  `json:"hybk"`
+		} `json:"pool"`
+	} `json:"data"`
+}
+
+// GetBrokenLimitPool fetches the broken-board pool (炸板池) of date (YYYY-MM-DD).
+// Like the limit-up pool only the recent sessions are available.
+func (c *Client) GetBrokenLimitPool(ctx context.Context, date string) ([]*BrokenLimitStock, error) {
+	url := fmt.Sprintf("https://push2ex.eastmoney.com/getTopicZBPool?ut=7eea3edcaed734bea9cbfc24409ed989&dpt=wz.ztgc&Pageindex=0&pagesize=10000&sort=fbt:asc&date=%s",
+		strings.ReplaceAll(date, "-", ""))
+
+	var result BrokenLimitPoolResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse broken limit pool: %v", err)
+	}
+	return parseBrokenLimitPool(&result), nil
+}
+
+// GetLimitDownPool fetches the limit-down pool (跌停池) of date (YYYY-MM-DD)
+func (c *Client) GetLimitDownPool(ctx context.Context, date string) ([]*LimitDownStock, error) {
+	url := fmt.Sprintf("https://push2ex.eastmoney.com/getTopicDTPool?ut=7eea3edcaed734bea9cbfc24409ed989&dpt=wz.ztgc&Pageindex=0&pagesize=10000&sort=fund:asc&date=%s",
+		strings.ReplaceAll(date, "-", ""))
+
+	var result LimitDownPoolResponse
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse limit down pool: %v", err)
+	}
+	return parseLimitDownPool(&result), nil
+}
+
+func parseBrokenLimitPool(result *BrokenLimitPoolResponse) []*BrokenLimitStock {
+	if result.Data == nil || result.Rc != 0 {
+		return []*BrokenLimitStock{}
+	}
+
+	stocks := make([]*BrokenLimitStock, 0, len(result.Data.Pool))
+	for _, item := range result.Data.Pool {
+		price := item.Price / 1000
+		limitPrice := item.LimitUpPrice / 1000
+		stocks = append(stocks, &BrokenLimitStock{
+			Code:          item.Code,
+			Name:          item.Name,
+			Price:         price,
+			ChangePercent: item.ChangePct,
+			LimitUpPrice:  limitPrice,
+			HighestChange: highestChange(price, item.ChangePct, limitPrice),
+			FirstSealTime: formatClock(item.FirstSealTime),
+			OpenCount:     item.OpenCount,
+			Amount:        item.Amount,
+			TurnoverRate:  item.TurnoverRate,
+			Amplitude:     item.Amplitude,
+			Reason:        item.Reason,
+		})
+	}
+	return stocks
+}
+
+func parseLimitDownPool(result *LimitDownPoolResponse) []*LimitDownStock {
+	if result.Data == nil || result.Rc != 0 {
+		return []*LimitDownStock{}
+	}
+
+	stocks := make([]*LimitDownStock, 0, len(result.Data.Pool))
+	for _, item := range result.Data.Pool {
+		stocks = append(stocks, &LimitDownStock{
+			Code:           item.Code,
+			Name:           item.Name,
+			Price:          item.Price / 1000,
+			ChangePercent:  item.ChangePct,
+			SealAmount:     item.SealAmount,
+			LastSealTime:   formatClock(item.LastSealTime),
+			BoardAmount:    item.BoardAmount,
+			ContinuousDays: item.ContinuousDays,
+			OpenCount:      item.OpenCount,
+			Amount:         item.Amount,
+			TurnoverRate:   item.TurnoverRate,
+			Reason:         item.Reason,
+		})
+	}
+	return stocks
+}
+
+// highestChange derives the change at the limit price from the current price and change,
+// the pool has no previous close
+func highestChange(price, changePct, limitPrice float64) float64 {
+	if price <= 0 || limitPrice <= 0 {
+		return 0
+	}
+	prevClose := price / (1 + changePct/100)
+	return math.Round((limitPrice/prevClose-1)*10000) / 100
+}
+
+// BrokenRate is the 炸板率 in percent: the share of stocks that touched limit-up
+// during the day but failed to close there
+func BrokenRate(limitUpCount, brokenCount int) float64 {
+	total := limitUpCount + brokenCount
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(brokenCount)/float64(total)*10000) / 100
+}
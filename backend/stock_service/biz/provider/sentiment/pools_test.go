@@ -0,0 +1,55 @@
+package sentiment
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBrokenLimitPool(t *testing.T) {
+	body := `{"rc":0,"data":{"tc":1,"pool":[
+		{"c":"300750","m":0,"n":"宁德时代","p":216000,"ztp":240000,"zdp":8.0,"amount":9876543210,"hs":3.2,"zs":0.1,"fbt":101530,"zbc":3,"zf":12.5,"hybk":"电池"}
+	]}}`
+
+	var result BrokenLimitPoolResponse
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	pool := parseBrokenLimitPool(&result)
+	if assert.Len(t, pool, 1) {
+		s := pool[0]
+		assert.Equal(t, 216.0, s.Price)
+		assert.Equal(t, 240.0, s.LimitUpPrice)
+		// prev close 200, limit 240
+		assert.Equal(t, 20.0, s.HighestChange)
+		assert.Equal(t, "10:15:30", s.FirstSealTime)
+		assert.Equal(t, 3, s.OpenCount)
+		assert.Equal(t, 12.5, s.Amplitude)
+	}
+}
+
+func TestParseLimitDownPool(t *testing.T) {
+	body := `{"rc":0,"data":{"tc":1,"pool":[
+		{"c":"600000","m":1,"n":"某某股份","p":9000,"zdp":-10.0,"amount":123000000,"hs":2.5,"fund":56000000,"lbt":143001,"fba":7800000,"days":2,"oc":1,"hybk":"银行"}
+	]}}`
+
+	var result LimitDownPoolResponse
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	pool := parseLimitDownPool(&result)
+	if assert.Len(t, pool, 1) {
+		s := pool[0]
+		assert.Equal(t, 9.0, s.Price)
+		assert.Equal(t, 56000000.0, s.SealAmount)
+		assert.Equal(t, "14:30:01", s.LastSealTime)
+		assert.Equal(t, 2, s.ContinuousDays)
+		assert.Equal(t, 1, s.OpenCount)
+	}
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"rc":102,"data":null}`), &result))
+	assert.Empty(t, parseLimitDownPool(&result))
+}
+
+func TestBrokenRate(t *testing.T) {
+	assert.Equal(t, 0.0, BrokenRate(0, 0))
+	assert.Equal(t, 25.0, BrokenRate(60, 20))
+	assert.Equal(t, 33.33, BrokenRate(2, 1))
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/biz/provider"
 	"stock_assistant/backend/stock_service/kitex_gen/stock"
 
 	"golang.org/x/text/encoding/simplifiedchinese"
@@ -29,25 +32,114 @@ func NewClient() *Client {
 	}
 }
 
+// Name implements provider.QuoteProvider
+func (c *Client) Name() string {
+	return "sina"
+}
+
 // GetStockInfo fetches real-time stock information
 // code format: sh600000, sz000001
 func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInfo, error) {
-	// Auto-fix code prefix if missing
-	code = strings.TrimSpace(code)
-	if len(code) == 6 {
-		if strings.HasPrefix(code, "6") {
-			code = "sh" + code
-		} else if strings.HasPrefix(code, "0") || strings.HasPrefix(code, "3") {
-			code = "sz" + code
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	code = sec.Prefixed()
+
+	content, err := c.fetch(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	// Response format: var hq_str_sh601006="大秦铁路,6.660,6.660,6.670,6.690,6.640,6.660,6.670,22759685,151717808.000,189400,6.660,119900,6.650,229400,6.640,147800,6.630,121700,6.620,100,6.670,165700,6.680,187800,6.690,266600,6.700,240200,6.710,2024-05-15,15:00:00,00,";
+
+	// Check if data is valid
+	if !strings.Contains(content, "=\"") {
+		return nil, fmt.Errorf("invalid stock code or empty response. Content: %s, Code: %s", content, code)
+	}
+
+	parts := strings.Split(content, "=\"")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("parse error. Content: %s", content)
+	}
+
+	dataStr := strings.TrimSuffix(parts[1], "\";\n")
+	// Some response might end with "; without newline or just ";
+	dataStr = strings.TrimSuffix(dataStr, "\";")
+
+	return parseFields(code, dataStr)
+}
+
+// GetStockInfos fetches several quotes in one request, Sina's list= parameter
+// accepts comma-separated codes and answers one line per code.
+// The result is keyed by the requested code; codes Sina does not know are left out.
+func (c *Client) GetStockInfos(ctx context.Context, codes []string) (map[string]*stock.StockInfo, error) {
+	// prefixed code -> requested codes (the same stock may be asked as 600519 and sh600519)
+	requested := make(map[string][]string)
+	var list []string
+	for _, code := range codes {
+		sec, err := symbol.Parse(code)
+		if err != nil {
+			// Left out of the result, the chain reports it per code
+			continue
+		}
+		prefixed := sec.Prefixed()
+		if _, ok := requested[prefixed]; !ok {
+			list = append(list, prefixed)
 		}
+		requested[prefixed] = append(requested[prefixed], code)
+	}
+	if len(list) == 0 {
+		return map[string]*stock.StockInfo{}, nil
 	}
 
+	content, err := c.fetch(ctx, strings.Join(list, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*stock.StockInfo, len(codes))
+	for prefixed, info := range parseLines(content) {
+		for _, code := range requested[prefixed] {
+			cp := *info
+			res[code] = &cp
+		}
+	}
+
+	return res, nil
+}
+
+// parseLines parses a multi-line list= response into quotes keyed by prefixed code.
+// Lines with an empty payload (unknown code) or a bad format are skipped.
+func parseLines(content string) map[string]*stock.StockInfo {
+	res := make(map[string]*stock.StockInfo)
+	for _, line := range strings.Split(content, "\n") {
+		// var hq_str_sh601006="...";
+		line = strings.TrimSpace(line)
+		idx := strings.Index(line, "=\"")
+		if !strings.HasPrefix(line, "var hq_str_") || idx == -1 {
+			continue
+		}
+		prefixed := strings.TrimPrefix(line[:idx], "var hq_str_")
+		dataStr := strings.TrimSuffix(line[idx+2:], ";")
+		dataStr = strings.TrimSuffix(dataStr, "\"")
+
+		info, err := parseFields(prefixed, dataStr)
+		if err != nil {
+			continue
+		}
+		res[prefixed] = info
+	}
+	return res
+}
+
+// fetch calls hq.sinajs.cn and returns the UTF-8 decoded body
+func (c *Client) fetch(ctx context.Context, list string) (string, error) {
 	// Sina API format: http://hq.sinajs.cn/list=sh601006
-	url := fmt.Sprintf("http://hq.sinajs.cn/list=%s", code)
+	url := fmt.Sprintf("http://hq.sinajs.cn/list=%s", list)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return "", fmt.Errorf("failed to create request: %v", err)
 	}
 	// Set headers to mimic a browser to avoid potential blocking
 	req.Header.Set("Referer", "https://finance.sina.com.cn/")
@@ -55,7 +147,7 @@ func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInf
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch data: %v", err)
+		return "", fmt.Errorf("failed to fetch data: %v", err)
 	}
 	defer resp.Body.Close()
 
@@ -63,37 +155,23 @@ func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInf
 	// Read raw bytes first to avoid reader error on invalid chars
 	rawBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %v", err)
+		return "", fmt.Errorf("failed to read response body: %v", err)
 	}
 
-	var content string
 	decoder := simplifiedchinese.GBK.NewDecoder()
 	utf8Body, _, err := transform.Bytes(decoder, rawBody)
 	if err != nil {
 		// If decoding fails (e.g. GB18030 specific chars), try to use raw body if it's mostly ASCII compatible for numbers
 		// Or try to ignore errors?
 		// For now, let's log and fallback to treating it as string (might be garbled for name but numbers work)
-		fmt.Printf("GBK decoding failed for %s: %v. Using raw string.\n", code, err)
-		content = string(rawBody)
-	} else {
-		content = string(utf8Body)
-	}
-	// Response format: var hq_str_sh601006="大秦铁路,6.660,6.660,6.670,6.690,6.640,6.660,6.670,22759685,151717808.000,189400,6.660,119900,6.650,229400,6.640,147800,6.630,121700,6.620,100,6.670,165700,6.680,187800,6.690,266600,6.700,240200,6.710,2024-05-15,15:00:00,00,";
-
-	// Check if data is valid
-	if !strings.Contains(content, "=\"") {
-		return nil, fmt.Errorf("invalid stock code or empty response. Content: %s, URL: %s", content, url)
+		log.Printf("sina: GBK decoding failed for %s, using the raw body: %v", list, err)
+		return string(rawBody), nil
 	}
+	return string(utf8Body), nil
+}
 
-	parts := strings.Split(content, "=\"")
-	if len(parts) < 2 {
-		return nil, fmt.Errorf("parse error. Content: %s", content)
-	}
-
-	dataStr := strings.TrimSuffix(parts[1], "\";\n")
-	// Some response might end with "; without newline or just ";
-	dataStr = strings.TrimSuffix(dataStr, "\";")
-
+// parseFields parses the comma-separated payload of one hq_str line
+func parseFields(code, dataStr string) (*stock.StockInfo, error) {
 	if dataStr == "" {
 		return nil, fmt.Errorf("empty data")
 	}
@@ -106,19 +184,23 @@ func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInf
 	// The length check should be more lenient or specific to the type.
 
 	// A basic valid response should have at least name, prices and date/time.
-	if len(fields) < 6 {
-		return nil, fmt.Errorf("unexpected data format: fields count %d. Data: %s, URL: %s, Content: %s", len(fields), dataStr, url, content)
+	if len(fields) < 32 {
+		return nil, fmt.Errorf("unexpected data format: fields count %d. Data: %s, Code: %s", len(fields), dataStr, code)
 	}
 
 	// Parse fields
+	// 0: name, 1: open, 2: prev close, 3: price, 4: high, 5: low,
+	// 8: volume (shares), 9: amount (CNY),
+	// 10-19: buy1..buy5 as (volume, price) pairs, 20-29: sell1..sell5 likewise,
+	// 30: date, 31: time
 	name := fields[0]
 	openPrice, _ := strconv.ParseFloat(fields[1], 64)
-	_ = openPrice // prevClose is fields[2]
 	prevClose, _ := strconv.ParseFloat(fields[2], 64)
 	currentPrice, _ := strconv.ParseFloat(fields[3], 64)
-	// fields[4] is high, fields[5] is low
-	// fields[8] is volume (shares)
+	high, _ := strconv.ParseFloat(fields[4], 64)
+	low, _ := strconv.ParseFloat(fields[5], 64)
 	volume, _ := strconv.ParseInt(fields[8], 10, 64)
+	amount, _ := strconv.ParseFloat(fields[9], 64)
 	date := fields[30]
 	timeStr := fields[31]
 
@@ -128,12 +210,30 @@ func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInf
 		changePercent = (currentPrice - prevClose) / prevClose * 100
 	}
 
-	return &stock.StockInfo{
+	info := &stock.StockInfo{
 		Code:          code,
 		Name:          name,
 		CurrentPrice:  currentPrice,
 		ChangePercent: changePercent,
 		Volume:        volume,
 		Timestamp:     fmt.Sprintf("%s %s", date, timeStr),
-	}, nil
+		Open:          openPrice,
+		High:          high,
+		Low:           low,
+		PrevClose:     prevClose,
+		Amount:        amount,
+	}
+	provider.SetOrderBook(info, parseLevels(fields[10:20]), parseLevels(fields[20:30]))
+	return info, nil
+}
+
+// parseLevels parses five (volume, price) pairs into order book levels
+func parseLevels(fields []string) []*stock.OrderBookLevel {
+	levels := make([]*stock.OrderBookLevel, 0, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		vol, _ := strconv.ParseInt(fields[i], 10, 64)
+		price, _ := strconv.ParseFloat(fields[i+1], 64)
+		levels = append(levels, &stock.OrderBookLevel{Price: price, Volume: vol})
+	}
+	return levels
 }
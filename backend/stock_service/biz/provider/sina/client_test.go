@@ -0,0 +1,57 @@
+package sina
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLines(t *testing.T) {
+	content := `var hq_str_sh600519="贵州茅台,1695.000,1690.000,1700.000,1710.000,1688.000,1699.990,1700.000,1234500,2098765432.000,500,1699.990,300,1699.980,200,1699.970,100,1699.960,400,1699.950,200,1700.000,600,1700.010,100,1700.020,300,1700.030,200,1700.040,2024-05-15,15:00:03,00,";
+var hq_str_sz000001="平安银行,10.100,10.000,10.200,10.250,10.050,10.190,10.200,98765400,1003456789.000,1000,10.190,2000,10.180,3000,10.170,4000,10.160,5000,10.150,1000,10.200,2000,10.210,3000,10.220,4000,10.230,5000,10.240,2024-05-15,15:00:00,00";
+var hq_str_sh000000="";
+`
+	res := parseLines(content)
+	assert.Len(t, res, 2)
+
+	info := res["sh600519"]
+	if assert.NotNil(t, info) {
+		assert.Equal(t, "贵州茅台", info.Name)
+		assert.Equal(t, 1700.0, info.CurrentPrice)
+		assert.Equal(t, int64(1234500), info.Volume)
+		assert.Equal(t, "2024-05-15 15:00:03", info.Timestamp)
+		assert.Equal(t, 1695.0, info.Open)
+		assert.Equal(t, 1710.0, info.High)
+		assert.Equal(t, 1688.0, info.Low)
+		assert.Equal(t, 1690.0, info.PrevClose)
+		assert.Equal(t, 2098765432.0, info.Amount)
+		if assert.Len(t, info.OrderBook.Bids, 5) && assert.Len(t, info.OrderBook.Asks, 5) {
+			assert.Equal(t, 1699.99, info.OrderBook.Bids[0].Price)
+			assert.Equal(t, int64(500), info.OrderBook.Bids[0].Volume)
+			assert.Equal(t, 1700.04, info.OrderBook.Asks[4].Price)
+		}
+		// bids 1500 shares, asks 1400 shares
+		assert.Equal(t, int64(100), info.WeiCha)
+		assert.Equal(t, 3.45, info.WeiBi)
+	}
+	info = res["sz000001"]
+	if assert.NotNil(t, info) {
+		assert.Equal(t, "平安银行", info.Name)
+		assert.InDelta(t, 2.0, info.ChangePercent, 1e-9)
+	}
+	assert.Nil(t, res["sh000000"])
+}
+
+func TestGetStockInfos(t *testing.T) {
+	client := NewClient()
+	res, err := client.GetStockInfos(context.Background(), []string{"600519", "sz000001", "sh000000"})
+	if err != nil {
+		t.Logf("Failed to get sina batch quote: %v", err)
+		return
+	}
+	for code, info := range res {
+		t.Logf("%s: %+v", code, info)
+	}
+	assert.Nil(t, res["sh000000"])
+}
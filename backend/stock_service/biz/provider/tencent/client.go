@@ -0,0 +1,142 @@
+package tencent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/biz/provider"
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/transform"
+)
+
+// Client handles interaction with Tencent Finance quote API (qt.gtimg.cn)
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new Tencent Finance API client
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+// Name implements provider.QuoteProvider
+func (c *Client) Name() string {
+	return "tencent"
+}
+
+// GetStockInfo fetches real-time stock information
+// code format: sh600000, sz000001 (bare six-digit codes are prefixed automatically)
+func (c *Client) GetStockInfo(ctx context.Context, code string) (*stock.StockInfo, error) {
+	sec, err := symbol.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	code = sec.Prefixed()
+
+	// Tencent API format: http://qt.gtimg.cn/q=sh600519
+	url := fmt.Sprintf("http://qt.gtimg.cn/q=%s", code)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Referer", "https://gu.qq.com/")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.114 Safari/537.36")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch data: %v", err)
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	// Tencent also answers in GBK
+	content := string(rawBody)
+	if utf8Body, _, err := transform.Bytes(simplifiedchinese.GBK.NewDecoder(), rawBody); err == nil {
+		content = string(utf8Body)
+	}
+
+	return parseQuote(code, content)
+}
+
+// parseQuote parses a single Tencent quote line.
+// Response format: v_sh600519="1~贵州茅台~600519~1700.00~1690.00~1695.00~12345~...~20240515150003~10.00~0.59~1710.00~1688.00~...";
+func parseQuote(code, content string) (*stock.StockInfo, error) {
+	parts := strings.SplitN(content, "=\"", 2)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid stock code or empty response. Content: %s", content)
+	}
+
+	dataStr := strings.TrimSpace(parts[1])
+	dataStr = strings.TrimSuffix(dataStr, ";")
+	dataStr = strings.TrimSuffix(dataStr, "\"")
+	if dataStr == "" {
+		return nil, fmt.Errorf("empty data")
+	}
+
+	fields := strings.Split(dataStr, "~")
+	// 0: market, 1: name, 2: code, 3: price, 4: prev close, 5: open, 6: volume (手),
+	// 9-18: buy1..buy5 as (price, 手) pairs, 19-28: sell1..sell5 likewise,
+	// 30: datetime (YYYYMMDDhhmmss), 32: change percent, 33: high, 34: low,
+	// 37: amount (万元)
+	if len(fields) < 38 {
+		return nil, fmt.Errorf("unexpected data format: fields count %d. Data: %s", len(fields), dataStr)
+	}
+
+	currentPrice, _ := strconv.ParseFloat(fields[3], 64)
+	prevClose, _ := strconv.ParseFloat(fields[4], 64)
+	openPrice, _ := strconv.ParseFloat(fields[5], 64)
+	changePercent, _ := strconv.ParseFloat(fields[32], 64)
+	high, _ := strconv.ParseFloat(fields[33], 64)
+	low, _ := strconv.ParseFloat(fields[34], 64)
+	amountWan, _ := strconv.ParseFloat(fields[37], 64)
+	volumeLots, _ := strconv.ParseFloat(fields[6], 64)
+
+	timestamp := fields[30]
+	if t, err := time.Parse("20060102150405", timestamp); err == nil {
+		timestamp = t.Format("2006-01-02 15:04:05")
+	}
+
+	info := &stock.StockInfo{
+		Code:          code,
+		Name:          fields[1],
+		CurrentPrice:  currentPrice,
+		ChangePercent: changePercent,
+		Volume:        int64(volumeLots) * 100, // keep shares, same unit as Sina
+		Timestamp:     timestamp,
+		Open:          openPrice,
+		High:          high,
+		Low:           low,
+		PrevClose:     prevClose,
+		Amount:        amountWan * 10000,
+	}
+	provider.SetOrderBook(info, parseLevels(fields[9:19]), parseLevels(fields[19:29]))
+	return info, nil
+}
+
+// parseLevels parses five (price, 手) pairs into order book levels in shares
+func parseLevels(fields []string) []*stock.OrderBookLevel {
+	levels := make([]*stock.OrderBookLevel, 0, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		price, _ := strconv.ParseFloat(fields[i], 64)
+		lots, _ := strconv.ParseInt(fields[i+1], 10, 64)
+		levels = append(levels, &stock.OrderBookLevel{Price: price, Volume: lots * 100})
+	}
+	return levels
+}
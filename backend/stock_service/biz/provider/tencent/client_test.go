@@ -0,0 +1,48 @@
+package tencent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuote(t *testing.T) {
+	content := `v_sh600519="1~贵州茅台~600519~1700.00~1690.00~1695.00~12345~6000~6345~1699.99~5~1699.98~3~1699.97~2~1699.96~1~1699.95~4~1700.00~2~1700.01~6~1700.02~1~1700.03~3~1700.04~2~~20240515150003~10.00~0.59~1710.00~1688.00~1700.00/12345/2098765432~12345~209877~0.10~28.50~";` + "\n"
+
+	info, err := parseQuote("sh600519", content)
+	assert.Nil(t, err)
+	assert.Equal(t, "sh600519", info.Code)
+	assert.Equal(t, "贵州茅台", info.Name)
+	assert.Equal(t, 1700.00, info.CurrentPrice)
+	assert.Equal(t, 0.59, info.ChangePercent)
+	assert.Equal(t, int64(1234500), info.Volume)
+	assert.Equal(t, "2024-05-15 15:00:03", info.Timestamp)
+	assert.Equal(t, 1695.00, info.Open)
+	assert.Equal(t, 1710.00, info.High)
+	assert.Equal(t, 1688.00, info.Low)
+	assert.Equal(t, 1690.00, info.PrevClose)
+	assert.Equal(t, 2098770000.0, info.Amount)
+	if assert.Len(t, info.OrderBook.Bids, 5) && assert.Len(t, info.OrderBook.Asks, 5) {
+		assert.Equal(t, 1699.99, info.OrderBook.Bids[0].Price)
+		assert.Equal(t, int64(500), info.OrderBook.Bids[0].Volume)
+		assert.Equal(t, 1700.04, info.OrderBook.Asks[4].Price)
+	}
+	// bids 15 手, asks 14 手
+	assert.Equal(t, int64(100), info.WeiCha)
+
+	_, err = parseQuote("sh000000", `v_pv_none_match="1";`)
+	assert.NotNil(t, err)
+}
+
+func TestGetStockInfo(t *testing.T) {
+	client := NewClient()
+	info, err := client.GetStockInfo(context.Background(), "600519")
+	if err != nil {
+		t.Logf("Failed to get tencent quote: %v", err)
+		return
+	}
+	assert.Equal(t, "sh600519", info.Code)
+	assert.NotEmpty(t, info.Name)
+	t.Logf("Tencent quote: %+v", info)
+}
@@ -0,0 +1,35 @@
+package sector
+
+import "stock_assistant/backend/stock_service/dal/model"
+
+// Flow is the daily main-force net inflow of one sector over a window of trading days
+type Flow struct {
+	NetInflows []float64 // One per window day, oldest first, 0 when the sector was not ranked
+	Streak     int       // Consecutive days of net inflow up to the latest window day
+}
+
+// Flows builds the Flow of every sector ranked on any of dates (oldest first), keyed by
+// sector code, from the closing ranking of each date. A day without a ranking ends a streak.
+func Flows(dates []string, byDate map[string][]*model.MarketSectorDaily) map[string]*Flow {
+	flows := make(map[string]*Flow)
+	ranked := make([]map[string]bool, len(dates))
+	for i, date := range dates {
+		ranked[i] = make(map[string]bool, len(byDate[date]))
+		for _, row := range byDate[date] {
+			f, ok := flows[row.SectorCode]
+			if !ok {
+				f = &Flow{NetInflows: make([]float64, len(dates))}
+				flows[row.SectorCode] = f
+			}
+			f.NetInflows[i] = row.NetInflow
+			ranked[i][row.SectorCode] = true
+		}
+	}
+
+	for code, f := range flows {
+		for i := len(dates) - 1; i >= 0 && ranked[i][code] && f.NetInflows[i] > 0; i-- {
+			f.Streak++
+		}
+	}
+	return flows
+}
@@ -0,0 +1,45 @@
+package sector
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlows(t *testing.T) {
+	dates := []string{"2024-05-13", "2024-05-14", "2024-05-15", "2024-05-16"}
+	byDate := map[string][]*model.MarketSectorDaily{
+		"2024-05-13": {
+			{SectorCode: "BK001", NetInflow: 1e8},
+			{SectorCode: "BK002", NetInflow: 2e8},
+		},
+		"2024-05-14": {
+			{SectorCode: "BK001", NetInflow: 3e8},
+			{SectorCode: "BK002", NetInflow: -1e8},
+		},
+		// 2024-05-15 not collected
+		"2024-05-16": {
+			{SectorCode: "BK001", NetInflow: 2e8},
+			{SectorCode: "BK002", NetInflow: 5e8},
+			{SectorCode: "BK003", NetInflow: -2e8},
+		},
+	}
+
+	flows := Flows(dates, byDate)
+	assert.Len(t, flows, 3)
+	assert.Equal(t, []float64{1e8, 3e8, 0, 2e8}, flows["BK001"].NetInflows)
+	// The missing day ends the streak
+	assert.Equal(t, 1, flows["BK001"].Streak)
+	assert.Equal(t, 1, flows["BK002"].Streak)
+	assert.Equal(t, 0, flows["BK003"].Streak)
+
+	byDate["2024-05-15"] = []*model.MarketSectorDaily{
+		{SectorCode: "BK001", NetInflow: 1e8},
+		{SectorCode: "BK002", NetInflow: 1e8},
+	}
+	flows = Flows(dates, byDate)
+	assert.Equal(t, 4, flows["BK001"].Streak)
+	assert.Equal(t, 2, flows["BK002"].Streak)
+}
@@ -0,0 +1,151 @@
+// Package sector derives multi-day views of the concept and industry rankings.
+package sector
+
+import (
+	"math"
+	"sort"
+
+	"stock_assistant/backend/stock_service/dal/model"
+)
+
+// TopRank is the rank a sector has to hold to count as a leading sector
+const TopRank = 10
+
+// Trend labels of a Rotation
+const (
+	TrendRising = "rising" // Climbed at least trendThreshold percentile points over the window
+	TrendFading = "fading" // Fell at least trendThreshold percentile points
+	TrendSteady = "steady"
+)
+
+const trendThreshold = 20
+
+// Rotation is how one sector ranked over a window of trading days
+type Rotation struct {
+	Code       string
+	Name       string
+	Type       string
+	Ranks      []int // One per window day, oldest first, 0 when the sector was not ranked
+	LatestRank int   // Rank on the latest day it was ranked
+	Total      int   // Sectors ranked on that day
+
+	CumulativeChange    float64 // Compounded change over the window (%)
+	CumulativeNetInflow float64 // Sum of the daily net inflow (CNY)
+	TopStreak           int     // Consecutive days in the top TopRank, up to the latest window day
+	Momentum            float64 // 0-100, see Momentum
+	Trend               string
+}
+
+// Compute builds the rotation of every sector over dates (oldest first) from the
+// closing ranking of each date, strongest momentum first. Dates without a ranking are
+// missing from byDate and leave 0 in Ranks.
+func Compute(dates []string, byDate map[string][]*model.MarketSectorDaily) []*Rotation {
+	// days[i] indexes the ranking of dates[i] by sector code
+	days := make([]map[string]*model.MarketSectorDaily, len(dates))
+	bySector := make(map[string]*Rotation)
+	var order []string
+	for i, date := range dates {
+		days[i] = make(map[string]*model.MarketSectorDaily, len(byDate[date]))
+		for _, row := range byDate[date] {
+			days[i][row.SectorCode] = row
+			r, ok := bySector[row.SectorCode]
+			if !ok {
+				r = &Rotation{Code: row.SectorCode, Type: row.Type, Ranks: make([]int, len(dates))}
+				bySector[row.SectorCode] = r
+				order = append(order, row.SectorCode)
+			}
+			r.Name = row.Name
+			r.Ranks[i] = row.Rank
+			r.LatestRank = row.Rank
+			r.Total = row.Total
+			r.CumulativeNetInflow += row.NetInflow
+		}
+	}
+
+	result := make([]*Rotation, 0, len(order))
+	for _, code := range order {
+		r := bySector[code]
+		r.CumulativeChange = cumulativeChange(days, code)
+		r.TopStreak = topStreak(r.Ranks)
+		r.Momentum, r.Trend = momentum(days, code)
+		result = append(result, r)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Momentum != result[j].Momentum {
+			return result[i].Momentum > result[j].Momentum
+		}
+		return result[i].LatestRank < result[j].LatestRank
+	})
+	return result
+}
+
+// Momentum scores a sector from 0 to 100 out of its daily rank percentiles p,
+// 100 for first place and 0 for last:
+//
+//	0.6 * weighted average of p, the i-th window day weighted i (recent days count more)
+//	+ 0.4 * (50 + (p on the latest ranked day - p on the first ranked day) / 2)
+//
+// so a sector that ranks high and keeps climbing scores highest. A change of at least
+// 20 percentile points marks the sector rising or fading.
+func momentum(days []map[string]*model.MarketSectorDaily, code string) (float64, string) {
+	var sum, weights float64
+	first, latest := -1.0, -1.0
+	for i, day := range days {
+		row := day[code]
+		if row == nil {
+			continue
+		}
+		p := percentile(row.Rank, row.Total)
+		w := float64(i + 1)
+		sum += p * w
+		weights += w
+		if first < 0 {
+			first = p
+		}
+		latest = p
+	}
+	if weights == 0 {
+		return 0, TrendSteady
+	}
+
+	delta := latest - first
+	score := 0.6*(sum/weights) + 0.4*(50+delta/2)
+
+	trend := TrendSteady
+	if delta >= trendThreshold {
+		trend = TrendRising
+	} else if delta <= -trendThreshold {
+		trend = TrendFading
+	}
+	return math.Round(score*100) / 100, trend
+}
+
+// percentile maps rank 1 to 100 and rank total to 0
+func percentile(rank, total int) float64 {
+	if total <= 1 {
+		return 100
+	}
+	return float64(total-rank) / float64(total-1) * 100
+}
+
+func cumulativeChange(days []map[string]*model.MarketSectorDaily, code string) float64 {
+	value := 1.0
+	for _, day := range days {
+		if row := day[code]; row != nil {
+			value *= 1 + row.ChangePercent/100
+		}
+	}
+	return math.Round((value-1)*10000) / 100
+}
+
+func topStreak(ranks []int) int {
+	n := 0
+	for i := len(ranks) - 1; i >= 0; i-- {
+		if ranks[i] < 1 || ranks[i] > TopRank {
+			break
+		}
+		n++
+	}
+	return n
+}
@@ -0,0 +1,55 @@
+package sector
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func row(code string, rank int, change, inflow float64) *model.MarketSectorDaily {
+	return &model.MarketSectorDaily{SectorCode: code, Name: code, Type: "concept", Rank: rank, Total: 21, ChangePercent: change, NetInflow: inflow}
+}
+
+func TestCompute(t *testing.T) {
+	dates := []string{"2025-09-26", "2025-09-29", "2025-09-30"}
+	byDate := map[string][]*model.MarketSectorDaily{
+		// A climbs from the bottom to the top, B falls from the top, C only shows up last
+		"2025-09-26": {row("B", 1, 5, 100), row("A", 21, -2, -50)},
+		"2025-09-29": {row("A", 6, 3, 80), row("B", 11, 1, 10)},
+		"2025-09-30": {row("A", 1, 4, 200), row("C", 3, 2, 0), row("B", 21, -3, -90)},
+	}
+
+	result := Compute(dates, byDate)
+	if !assert.Len(t, result, 3) {
+		return
+	}
+	a, c, b := result[0], result[1], result[2]
+	assert.Equal(t, "A", a.Code)
+	assert.Equal(t, "C", c.Code)
+	assert.Equal(t, "B", b.Code)
+
+	assert.Equal(t, []int{21, 6, 1}, a.Ranks)
+	assert.Equal(t, 1, a.LatestRank)
+	assert.Equal(t, 2, a.TopStreak)
+	assert.Equal(t, 230.0, a.CumulativeNetInflow)
+	// 0.98 * 1.03 * 1.04
+	assert.Equal(t, 4.98, a.CumulativeChange)
+	assert.Equal(t, TrendRising, a.Trend)
+	// Percentiles 0, 75, 100 weighted 1, 2, 3 -> 75; trend 50 + 100/2 = 100
+	assert.Equal(t, 0.6*75+0.4*100, a.Momentum)
+
+	assert.Equal(t, TrendFading, b.Trend)
+	assert.Equal(t, 0, b.TopStreak)
+
+	// Ranked once at the 90th percentile: 0.6*90 + 0.4*50
+	assert.Equal(t, 74.0, c.Momentum)
+	assert.Equal(t, []int{0, 0, 3}, c.Ranks)
+	assert.Equal(t, TrendSteady, c.Trend)
+	assert.Equal(t, 1, c.TopStreak)
+}
+
+func TestComputeEmpty(t *testing.T) {
+	assert.Empty(t, Compute([]string{"2025-09-30"}, nil))
+}
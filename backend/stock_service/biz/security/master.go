@@ -0,0 +1,309 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+	"stock_assistant/backend/stock_service/dal/model"
+	"stock_assistant/backend/stock_service/dal/mysql"
+)
+
+const (
+	// DefaultRefreshInterval is how often the master is reloaded from the vendor,
+	// listings and renames only change between sessions.
+	DefaultRefreshInterval = 24 * time.Hour
+
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+// Fetcher loads the full security list from a vendor
+type Fetcher interface {
+	// GetSecurityList returns the list and whether it is the whole universe
+	GetSecurityList(ctx context.Context) (items []*eastmoney.SecurityItem, complete bool, err error)
+}
+
+// Master is the in-memory security master backed by the securities table.
+// It answers code lookups and code/name/pinyin searches.
+type Master struct {
+	fetcher Fetcher
+
+	mu        sync.RWMutex
+	items     []*model.Security
+	byCode    map[string]*model.Security
+	updatedAt time.Time
+}
+
+// NewMaster creates an empty master, call Start (or Load/Refresh) to fill it
+func NewMaster(fetcher Fetcher) *Master {
+	return &Master{
+		fetcher: fetcher,
+		byCode:  make(map[string]*model.Security),
+	}
+}
+
+// Start loads the persisted master, refreshes it from the vendor when it is empty
+// or stale, then keeps refreshing it every interval until ctx is done.
+func (m *Master) Start(ctx context.Context, interval time.Duration) {
+	if err := m.Load(); err != nil {
+		log.Printf("Security master: load from mysql failed: %v", err)
+	}
+
+	go func() {
+		if m.Len() == 0 || time.Since(m.UpdatedAt()) >= interval {
+			m.refreshAndLog(ctx)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.refreshAndLog(ctx)
+			}
+		}
+	}()
+}
+
+func (m *Master) refreshAndLog(ctx context.Context) {
+	if err := m.Refresh(ctx); err != nil {
+		log.Printf("Security master: refresh failed: %v", err)
+		return
+	}
+	log.Printf("Security master: refreshed, %d securities", m.Len())
+}
+
+// Load fills the master from the securities table
+func (m *Master) Load() error {
+	list, err := mysql.LoadSecurities()
+	if err != nil {
+		return err
+	}
+
+	var updatedAt time.Time
+	for _, s := range list {
+		if s.UpdatedAt.After(updatedAt) {
+			updatedAt = s.UpdatedAt
+		}
+	}
+	m.set(list, updatedAt)
+	return nil
+}
+
+// Refresh reloads the full list from the vendor and persists it, dropping the
+// securities no longer listed when the list is complete. The in-memory master is
+// replaced even when persisting fails.
+func (m *Master) Refresh(ctx context.Context) error {
+	items, complete, err := m.fetcher.GetSecurityList(ctx)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("vendor returned an empty security list")
+	}
+
+	list := make([]*model.Security, 0, len(items))
+	for _, it := range items {
+		list = append(list, &model.Security{
+			Code:        strings.ToLower(it.Exchange) + it.Symbol,
+			Symbol:      it.Symbol,
+			Exchange:    it.Exchange,
+			Board:       boardOf(it.Exchange, it.Symbol, it.Type),
+			Type:        it.Type,
+			Name:        it.Name,
+			Pinyin:      strings.Join(Initials(it.Name), ","),
+			ListingDate: it.ListingDate,
+		})
+	}
+
+	// Whole seconds, so the stamp compares equal once stored in a DATETIME column
+	refreshedAt := time.Now().Truncate(time.Second)
+	if !complete {
+		log.Printf("Security master: vendor list incomplete (%d items), keeping securities not seen in this refresh", len(list))
+	}
+	if err := mysql.SaveSecurities(list, refreshedAt, complete); err != nil {
+		log.Printf("Security master: persist failed: %v", err)
+	}
+	m.set(list, refreshedAt)
+	return nil
+}
+
+// Lookup returns the security with the given prefixed code (sh600519), or nil
+func (m *Master) Lookup(code string) *model.Security {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.byCode[strings.ToLower(strings.TrimSpace(code))]
+}
+
+// Len returns the number of securities in the master
+func (m *Master) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.items)
+}
+
+// UpdatedAt returns when the master was last refreshed
+func (m *Master) UpdatedAt() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.updatedAt
+}
+
+func (m *Master) set(list []*model.Security, updatedAt time.Time) {
+	byCode := make(map[string]*model.Security, len(list))
+	for _, s := range list {
+		byCode[s.Code] = s
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items = list
+	m.byCode = byCode
+	m.updatedAt = updatedAt
+}
+
+// Search matches query against code, Chinese name and pinyin initials.
+// Exact and prefix matches rank above substring matches, which rank above
+// fuzzy (in-order subsequence) matches. Stocks rank above ETFs and indices on ties.
+func (m *Master) Search(query string, limit int) []*model.Security {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	type hit struct {
+		s     *model.Security
+		score int
+	}
+	var hits []hit
+
+	m.mu.RLock()
+	for _, s := range m.items {
+		if score := matchScore(s, query); score > 0 {
+			hits = append(hits, hit{s, score})
+		}
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		if ti, tj := typeRank(hits[i].s.Type), typeRank(hits[j].s.Type); ti != tj {
+			return ti < tj
+		}
+		return hits[i].s.Code < hits[j].s.Code
+	})
+
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	res := make([]*model.Security, 0, len(hits))
+	for _, h := range hits {
+		res = append(res, h.s)
+	}
+	return res
+}
+
+func matchScore(s *model.Security, q string) int {
+	switch {
+	case s.Code == q || s.Symbol == q:
+		return 100
+	case strings.EqualFold(s.Name, q):
+		return 95
+	case strings.HasPrefix(s.Symbol, q) || strings.HasPrefix(s.Code, q):
+		return 90
+	case strings.HasPrefix(strings.ToLower(s.Name), q):
+		return 80
+	}
+
+	pinyins := strings.Split(s.Pinyin, ",")
+	for _, py := range pinyins {
+		if py == q {
+			return 85
+		}
+	}
+	for _, py := range pinyins {
+		if py != "" && strings.HasPrefix(py, q) {
+			return 70
+		}
+	}
+
+	switch {
+	case strings.Contains(strings.ToLower(s.Name), q):
+		return 60
+	case strings.Contains(s.Symbol, q):
+		return 50
+	}
+	for _, py := range pinyins {
+		if py != "" && strings.Contains(py, q) {
+			return 45
+		}
+	}
+
+	// Fuzzy: the query characters appear in order (gzt -> gzmt, 茅台酒 misses, 贵茅 -> 贵州茅台)
+	if isSubsequence(q, strings.ToLower(s.Name)) {
+		return 30
+	}
+	for _, py := range pinyins {
+		if py != "" && isSubsequence(q, py) {
+			return 20
+		}
+	}
+	return 0
+}
+
+func isSubsequence(q, s string) bool {
+	qr := []rune(q)
+	if len(qr) < 2 {
+		return false
+	}
+	i := 0
+	for _, r := range s {
+		if r == qr[i] {
+			i++
+			if i == len(qr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typeRank(t string) int {
+	switch t {
+	case "stock":
+		return 0
+	case "etf":
+		return 1
+	}
+	return 2
+}
+
+// boardOf classifies a security, the vendor's type wins over the code prefix
+// since the vendor lists funds and indices whose codes look like stocks.
+func boardOf(exchange, code, secType string) string {
+	if secType == "etf" || secType == "index" {
+		return secType
+	}
+	sec, err := symbol.Parse(strings.ToLower(exchange) + code)
+	if err != nil || sec.Type != symbol.TypeStock {
+		return string(symbol.BoardMain)
+	}
+	return string(sec.Board)
+}
@@ -0,0 +1,100 @@
+package security
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeFetcher struct {
+	items []*eastmoney.SecurityItem
+}
+
+func (f *fakeFetcher) GetSecurityList(ctx context.Context) ([]*eastmoney.SecurityItem, bool, error) {
+	return f.items, true, nil
+}
+
+func newTestMaster(t *testing.T) *Master {
+	m := NewMaster(&fakeFetcher{items: []*eastmoney.SecurityItem{
+		{Symbol: "600519", Exchange: "SH", Type: "stock", Name: "贵州茅台", ListingDate: "2001-08-27"},
+		{Symbol: "000001", Exchange: "SZ", Type: "stock", Name: "平安银行"},
+		{Symbol: "000001", Exchange: "SH", Type: "index", Name: "上证指数"},
+		{Symbol: "688981", Exchange: "SH", Type: "stock", Name: "中芯国际"},
+		{Symbol: "300750", Exchange: "SZ", Type: "stock", Name: "宁德时代"},
+		{Symbol: "510300", Exchange: "SH", Type: "etf", Name: "沪深300ETF"},
+		{Symbol: "920001", Exchange: "BJ", Type: "stock", Name: "纬达光电"},
+	}})
+	// mysql is not initialized in tests, Refresh still fills the in-memory master
+	assert.Nil(t, m.Refresh(context.Background()))
+	return m
+}
+
+func TestInitials(t *testing.T) {
+	// The most common reading comes first, rare readings of 台 follow
+	assert.Equal(t, "gzmt", Initials("贵州茅台")[0])
+	assert.Contains(t, Initials("平安银行"), "payh")
+	assert.Equal(t, "hs300etf", Initials("沪深300ETF")[0])
+	assert.Equal(t, "stzn", Initials("*ST中南")[0])
+	assert.Nil(t, Initials("*"))
+}
+
+func TestMasterRefresh(t *testing.T) {
+	m := newTestMaster(t)
+	assert.Equal(t, 7, m.Len())
+	assert.WithinDuration(t, time.Now(), m.UpdatedAt(), time.Minute)
+
+	s := m.Lookup("SH688981")
+	if assert.NotNil(t, s) {
+		assert.Equal(t, "star", s.Board)
+		assert.Equal(t, "2001-08-27", m.Lookup("sh600519").ListingDate)
+		assert.Contains(t, s.Pinyin, "zxgj")
+	}
+	assert.Equal(t, "chinext", m.Lookup("sz300750").Board)
+	assert.Equal(t, "bse", m.Lookup("bj920001").Board)
+	assert.Equal(t, "etf", m.Lookup("sh510300").Board)
+	assert.Equal(t, "index", m.Lookup("sh000001").Board)
+	assert.Nil(t, m.Lookup("sh999999"))
+}
+
+func TestMasterSearch(t *testing.T) {
+	m := newTestMaster(t)
+
+	res := m.Search("gzmt", 10)
+	if assert.NotEmpty(t, res) {
+		assert.Equal(t, "sh600519", res[0].Code)
+	}
+
+	// Exact code: the stock ranks above the index sharing the symbol
+	res = m.Search("000001", 10)
+	if assert.Len(t, res, 2) {
+		assert.Equal(t, "sz000001", res[0].Code)
+		assert.Equal(t, "sh000001", res[1].Code)
+	}
+
+	res = m.Search("6005", 10)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "sh600519", res[0].Code)
+	}
+
+	res = m.Search("茅台", 10)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "sh600519", res[0].Code)
+	}
+
+	// Fuzzy subsequence on pinyin
+	res = m.Search("ndsd", 10)
+	if assert.NotEmpty(t, res) {
+		assert.Equal(t, "sz300750", res[0].Code)
+	}
+	res = m.Search("gzt", 10)
+	if assert.NotEmpty(t, res) {
+		assert.Equal(t, "sh600519", res[0].Code)
+	}
+
+	assert.Len(t, m.Search("0", 2), 2)
+	assert.Empty(t, m.Search("  ", 10))
+}
@@ -0,0 +1,65 @@
+package security
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+// maxPinyinVariants bounds the combinations generated for names with polyphonic characters
+const maxPinyinVariants = 4
+
+var initialsArgs = func() pinyin.Args {
+	a := pinyin.NewArgs()
+	a.Style = pinyin.FirstLetter
+	a.Heteronym = true
+	return a
+}()
+
+// Initials returns the pinyin abbreviations of a security name, e.g. 贵州茅台 -> gzmt.
+// Latin letters and digits are kept as is (lower-cased), other symbols like * are dropped.
+// Polyphonic characters produce several variants (平安银行 -> payx, payh), the first one
+// uses the most common reading.
+func Initials(name string) []string {
+	variants := []string{""}
+	for _, r := range name {
+		var letters []string
+		switch {
+		case unicode.Is(unicode.Han, r):
+			letters = dedupe(pinyin.SinglePinyin(r, initialsArgs))
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			letters = []string{strings.ToLower(string(r))}
+		}
+		if len(letters) == 0 {
+			continue
+		}
+
+		next := make([]string, 0, len(variants)*len(letters))
+		for _, v := range variants {
+			for _, l := range letters {
+				if len(next) >= maxPinyinVariants {
+					break
+				}
+				next = append(next, v+l)
+			}
+		}
+		variants = next
+	}
+	if variants[0] == "" {
+		return nil
+	}
+	return variants
+}
+
+func dedupe(list []string) []string {
+	res := list[:0:0]
+	seen := make(map[string]bool, len(list))
+	for _, s := range list {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			res = append(res, s)
+		}
+	}
+	return res
+}
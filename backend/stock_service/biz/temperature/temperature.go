@@ -0,0 +1,169 @@
+// Package temperature computes the composite market temperature (情绪温度), one number
+// from 0 (ice cold) to 100 (overheated) per trading day.
+//
+// Each component is first mapped to a 0-100 sub-score, the temperature is their
+// weighted average:
+//
+//	breadth     advancers / (advancers + decliners)
+//	limit_up    limit-up count, full at LimitUpFull
+//	seal        100 - broken-board rate (炸板率)
+//	height      (highest board - 1) / (HeightFull - 1)
+//	limit_down  1 - limit-down count / LimitDownFull
+//	turnover    turnover change vs the previous session, -TurnoverRange% maps to 0 and +TurnoverRange% to 100
+//
+// The weights and the saturation points come from Config and can be tuned in
+// conf/temperature.json. A component without data (no stored breadth, no previous
+// session) is left out and the other weights are scaled up.
+package temperature
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+)
+
+// Component names, in display order
+const (
+	ComponentBreadth   = "breadth"
+	ComponentLimitUp   = "limit_up"
+	ComponentSeal      = "seal"
+	ComponentHeight    = "height"
+	ComponentLimitDown = "limit_down"
+	ComponentTurnover  = "turnover"
+)
+
+// Weights are the relative weights of the components, they need not add up to 100
+type Weights struct {
+	Breadth   float64 `json:"breadth"`
+	LimitUp   float64 `json:"limit_up"`
+	Seal      float64 `json:"seal"`
+	Height    float64 `json:"height"`
+	LimitDown float64 `json:"limit_down"`
+	Turnover  float64 `json:"turnover"`
+}
+
+// Config is the tunable part of the formula
+type Config struct {
+	Weights       Weights `json:"weights"`
+	LimitUpFull   int     `json:"limit_up_full"`   // Limit-up count that scores 100
+	HeightFull    int     `json:"height_full"`     // Board height that scores 100
+	LimitDownFull int     `json:"limit_down_full"` // Limit-down count that scores 0
+	TurnoverRange float64 `json:"turnover_range"`  // Turnover change (%) that scores 0 or 100
+}
+
+// DefaultConfig is used when no config file is found, and fills the fields a file leaves out
+func DefaultConfig() Config {
+	return Config{
+		Weights: Weights{
+			Breadth:   25,
+			LimitUp:   20,
+			Seal:      15,
+			Height:    15,
+			LimitDown: 10,
+			Turnover:  15,
+		},
+		LimitUpFull:   100,
+		HeightFull:    7,
+		LimitDownFull: 50,
+		TurnoverRange: 30,
+	}
+}
+
+// LoadConfig reads a JSON config file on top of DefaultConfig
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return DefaultConfig(), err
+	}
+	return cfg, nil
+}
+
+// Inputs are the day's raw figures
+type Inputs struct {
+	LimitUp      int
+	Broken       int
+	LimitDown    int
+	HighestBoard int
+
+	HasBreadth bool // Advance and Decline are known
+	Advance    int
+	Decline    int
+
+	HasTurnover    bool    // TurnoverChange is known
+	TurnoverChange float64 // Turnover vs the previous session (%)
+}
+
+// Component is one part of the temperature
+type Component struct {
+	Name   string  `json:"name"`
+	Value  float64 `json:"value"`  // Sub-score, 0-100
+	Weight float64 `json:"weight"` // Share of the temperature (%), 0 when the data was missing
+}
+
+// Result is the temperature of one day with its breakdown
+type Result struct {
+	Score      float64
+	Components []Component
+}
+
+// Compute applies the formula to in
+func (c Config) Compute(in Inputs) Result {
+	w := c.Weights
+	parts := []struct {
+		name      string
+		weight    float64
+		value     float64
+		available bool
+	}{
+		{ComponentBreadth, w.Breadth, ratio(in.Advance, in.Advance+in.Decline, 0.5), in.HasBreadth},
+		{ComponentLimitUp, w.LimitUp, saturate(float64(in.LimitUp), float64(c.LimitUpFull)), true},
+		{ComponentSeal, w.Seal, 1 - ratio(in.Broken, in.LimitUp+in.Broken, 0), true},
+		{ComponentHeight, w.Height, saturate(float64(in.HighestBoard-1), float64(c.HeightFull-1)), true},
+		{ComponentLimitDown, w.LimitDown, 1 - saturate(float64(in.LimitDown), float64(c.LimitDownFull)), true},
+		{ComponentTurnover, w.Turnover, saturate(in.TurnoverChange+c.TurnoverRange, 2*c.TurnoverRange), in.HasTurnover},
+	}
+
+	var total float64
+	for _, p := range parts {
+		if p.available && p.weight > 0 {
+			total += p.weight
+		}
+	}
+
+	result := Result{Components: make([]Component, 0, len(parts))}
+	for _, p := range parts {
+		comp := Component{Name: p.name, Value: round2(p.value * 100)}
+		if p.available && p.weight > 0 && total > 0 {
+			share := p.weight / total
+			comp.Weight = round2(share * 100)
+			result.Score += share * p.value * 100
+		}
+		result.Components = append(result.Components, comp)
+	}
+	result.Score = round2(result.Score)
+	return result
+}
+
+// ratio is n/total, def when total is 0
+func ratio(n, total int, def float64) float64 {
+	if total <= 0 {
+		return def
+	}
+	return float64(n) / float64(total)
+}
+
+// saturate maps v in [0, full] to [0, 1], clamping outside
+func saturate(v, full float64) float64 {
+	if full <= 0 {
+		return 0
+	}
+	return math.Max(0, math.Min(v/full, 1))
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,67 @@
+package temperature
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompute(t *testing.T) {
+	cfg := DefaultConfig()
+
+	hot := cfg.Compute(Inputs{
+		LimitUp: 120, Broken: 0, LimitDown: 0, HighestBoard: 9,
+		HasBreadth: true, Advance: 5000, Decline: 0,
+		HasTurnover: true, TurnoverChange: 40,
+	})
+	assert.Equal(t, 100.0, hot.Score)
+
+	cold := cfg.Compute(Inputs{
+		LimitUp: 0, Broken: 10, LimitDown: 80, HighestBoard: 1,
+		HasBreadth: true, Advance: 0, Decline: 5000,
+		HasTurnover: true, TurnoverChange: -40,
+	})
+	assert.Equal(t, 0.0, cold.Score)
+
+	// 60 limit-ups (60), 25% broken (75), 4 boards (50), 10 limit-downs (80),
+	// 60% advancers (60), turnover +15% (75)
+	mid := cfg.Compute(Inputs{
+		LimitUp: 60, Broken: 20, LimitDown: 10, HighestBoard: 4,
+		HasBreadth: true, Advance: 3000, Decline: 2000,
+		HasTurnover: true, TurnoverChange: 15,
+	})
+	// 0.25*60 + 0.2*60 + 0.15*75 + 0.15*50 + 0.1*80 + 0.15*75
+	assert.Equal(t, 65.0, mid.Score)
+	if assert.Len(t, mid.Components, 6) {
+		assert.Equal(t, Component{Name: ComponentBreadth, Value: 60, Weight: 25}, mid.Components[0])
+		assert.Equal(t, Component{Name: ComponentSeal, Value: 75, Weight: 15}, mid.Components[2])
+	}
+}
+
+func TestComputeMissingData(t *testing.T) {
+	cfg := DefaultConfig()
+	r := cfg.Compute(Inputs{LimitUp: 100, HighestBoard: 7})
+
+	// Breadth and turnover are left out, the other weights add up to 60 and are scaled to 100
+	assert.Equal(t, 0.0, r.Components[0].Weight)
+	assert.Equal(t, 0.0, r.Components[5].Weight)
+	assert.Equal(t, 33.33, r.Components[1].Weight)
+	assert.Equal(t, 100.0, r.Score)
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "temperature.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"weights":{"breadth":50},"height_full":5}`), 0o644))
+
+	cfg, err := LoadConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, cfg.Weights.Breadth)
+	assert.Equal(t, 20.0, cfg.Weights.LimitUp, "unset fields keep the default")
+	assert.Equal(t, 5, cfg.HeightFull)
+
+	cfg, err = LoadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NotNil(t, err)
+	assert.Equal(t, DefaultConfig(), cfg)
+}
@@ -0,0 +1,76 @@
+// Package valuation places the latest PE, PB and PS of a stock within its own history
+// and against the stocks of its industry.
+package valuation
+
+import (
+	"sort"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+)
+
+// Bands returns the PE-TTM, PB and PS-TTM bands of days (oldest first) against the
+// industry's valuations of the latest session
+func Bands(days, industry []*stock.ValuationDay) (pe, pb, ps *stock.ValuationBand) {
+	pick := func(list []*stock.ValuationDay, value func(*stock.ValuationDay) float64) []float64 {
+		values := make([]float64, 0, len(list))
+		for _, d := range list {
+			values = append(values, value(d))
+		}
+		return values
+	}
+	peOf := func(d *stock.ValuationDay) float64 { return d.PeTtm }
+	pbOf := func(d *stock.ValuationDay) float64 { return d.Pb }
+	psOf := func(d *stock.ValuationDay) float64 { return d.PsTtm }
+
+	return Band(pick(days, peOf), pick(industry, peOf)),
+		Band(pick(days, pbOf), pick(industry, pbOf)),
+		Band(pick(days, psOf), pick(industry, psOf))
+}
+
+// Band places the last of history within history and against industry. Ratios that are not
+// positive (a loss or a negative equity) mean nothing on a percentile and are left out;
+// the band stays empty when the latest one is such a ratio.
+func Band(history, industry []float64) *stock.ValuationBand {
+	if len(history) == 0 {
+		return &stock.ValuationBand{}
+	}
+	current := history[len(history)-1]
+	if current <= 0 {
+		return &stock.ValuationBand{}
+	}
+
+	values := positive(history)
+	sort.Float64s(values)
+	lower := sort.SearchFloat64s(values, current) // Sessions valued strictly lower
+	return &stock.ValuationBand{
+		Current:        current,
+		Percentile:     float64(lower) / float64(len(values)) * 100,
+		Min:            values[0],
+		Median:         median(values),
+		Max:            values[len(values)-1],
+		IndustryMedian: median(positive(industry)),
+	}
+}
+
+func positive(values []float64) []float64 {
+	result := make([]float64, 0, len(values))
+	for _, v := range values {
+		if v > 0 {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// median sorts values in place, 0 when there are none
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
@@ -0,0 +1,55 @@
+package valuation
+
+import (
+	"testing"
+
+	"stock_assistant/backend/stock_service/kitex_gen/stock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBand(t *testing.T) {
+	// 10 sessions, the last one is valued higher than 2 of them
+	history := []float64{30, 25, 40, 35, 28, 50, 45, -5, 38, 29}
+	industry := []float64{10, 20, -3, 30, 40}
+
+	band := Band(history, industry)
+	assert.Equal(t, 29.0, band.Current)
+	// The loss making session is left out: 25 and 28 of 9 sessions are lower
+	assert.InDelta(t, 2.0/9*100, band.Percentile, 1e-9)
+	assert.Equal(t, 25.0, band.Min)
+	assert.Equal(t, 35.0, band.Median)
+	assert.Equal(t, 50.0, band.Max)
+	assert.Equal(t, 25.0, band.IndustryMedian)
+
+	// The input is left in order
+	assert.Equal(t, 29.0, history[len(history)-1])
+}
+
+func TestBandLowestAndHighest(t *testing.T) {
+	assert.Equal(t, 0.0, Band([]float64{3, 2, 1}, nil).Percentile)
+	assert.InDelta(t, 2.0/3*100, Band([]float64{1, 2, 3}, nil).Percentile, 1e-9)
+	assert.Equal(t, 0.0, Band([]float64{1, 2, 3}, nil).IndustryMedian)
+}
+
+func TestBandNegativeCurrent(t *testing.T) {
+	band := Band([]float64{10, 12, -4}, []float64{15})
+	assert.Equal(t, &stock.ValuationBand{}, band)
+	assert.Equal(t, &stock.ValuationBand{}, Band(nil, nil))
+}
+
+func TestBands(t *testing.T) {
+	days := []*stock.ValuationDay{
+		{Date: "2024-05-14", PeTtm: 20, Pb: 3, PsTtm: 5},
+		{Date: "2024-05-15", PeTtm: -8, Pb: 4, PsTtm: 6},
+	}
+	industry := []*stock.ValuationDay{{PeTtm: 15, Pb: 2, PsTtm: 1}, {PeTtm: 25, Pb: 2, PsTtm: 3}}
+
+	pe, pb, ps := Bands(days, industry)
+	assert.Equal(t, &stock.ValuationBand{}, pe)
+	assert.Equal(t, 4.0, pb.Current)
+	assert.Equal(t, 50.0, pb.Percentile)
+	assert.Equal(t, 2.0, pb.IndustryMedian)
+	assert.Equal(t, 6.0, ps.Current)
+	assert.Equal(t, 2.0, ps.IndustryMedian)
+}
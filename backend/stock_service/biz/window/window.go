@@ -0,0 +1,62 @@
+// Package window resolves the date window of the calendar queries (earnings, unlocks,
+// dividends): a capped range around today for the whole market, history plus the year
+// ahead for one stock.
+package window
+
+import (
+	"fmt"
+	"time"
+
+	"stock_assistant/backend/common/calendar"
+)
+
+const (
+	DefaultMarketDays = 14
+	MaxMarketDays     = 62
+
+	// stockHistoryYears and stockAheadYears bound the default window of one stock
+	stockHistoryYears = 3
+	stockAheadYears   = 1
+)
+
+// Window is an inclusive range of YYYY-MM-DD dates
+type Window struct {
+	Start string
+	End   string
+}
+
+// New applies the defaults to a requested window. The market window starts today and
+// is capped at MaxMarketDays; the window of one stock spans its history and the year ahead.
+func New(start, end string, perStock bool, today time.Time) (Window, error) {
+	var from, to time.Time
+	var err error
+	if start == "" {
+		from = today
+		if perStock {
+			from = today.AddDate(-stockHistoryYears, 0, 0)
+		}
+	} else if from, err = time.Parse(calendar.DateLayout, start); err != nil {
+		return Window{}, fmt.Errorf("invalid start date %q, expected YYYY-MM-DD", start)
+	}
+	if end == "" {
+		to = from.AddDate(0, 0, DefaultMarketDays)
+		if perStock {
+			to = today.AddDate(stockAheadYears, 0, 0)
+		}
+	} else if to, err = time.Parse(calendar.DateLayout, end); err != nil {
+		return Window{}, fmt.Errorf("invalid end date %q, expected YYYY-MM-DD", end)
+	}
+
+	if to.Before(from) {
+		return Window{}, fmt.Errorf("end date %s is before start date %s", to.Format(calendar.DateLayout), from.Format(calendar.DateLayout))
+	}
+	if !perStock && to.Sub(from) > MaxMarketDays*24*time.Hour {
+		return Window{}, fmt.Errorf("window of %s to %s is too long, at most %d days", from.Format(calendar.DateLayout), to.Format(calendar.DateLayout), MaxMarketDays)
+	}
+	return Window{Start: from.Format(calendar.DateLayout), End: to.Format(calendar.DateLayout)}, nil
+}
+
+// Contains reports whether date falls within the window
+func (w Window) Contains(date string) bool {
+	return date != "" && date >= w.Start && date <= w.End
+}
@@ -0,0 +1,45 @@
+package window
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var today = time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+
+func TestNewDefaults(t *testing.T) {
+	w, err := New("", "", false, today)
+	assert.Nil(t, err)
+	assert.Equal(t, Window{Start: "2024-04-10", End: "2024-04-24"}, w)
+
+	w, err = New("2024-04-20", "", false, today)
+	assert.Nil(t, err)
+	assert.Equal(t, Window{Start: "2024-04-20", End: "2024-05-04"}, w)
+
+	w, err = New("", "", true, today)
+	assert.Nil(t, err)
+	assert.Equal(t, Window{Start: "2021-04-10", End: "2025-04-10"}, w)
+}
+
+func TestNewInvalid(t *testing.T) {
+	_, err := New("2024/04/10", "", false, today)
+	assert.Error(t, err)
+	_, err = New("2024-04-10", "2024-04-01", false, today)
+	assert.Error(t, err)
+
+	// Too long for the market, fine for one stock
+	_, err = New("2024-01-01", "2024-06-30", false, today)
+	assert.Error(t, err)
+	_, err = New("2024-01-01", "2024-06-30", true, today)
+	assert.Nil(t, err)
+}
+
+func TestContains(t *testing.T) {
+	w := Window{Start: "2024-04-10", End: "2024-04-24"}
+	assert.True(t, w.Contains("2024-04-10"))
+	assert.True(t, w.Contains("2024-04-24"))
+	assert.False(t, w.Contains("2024-04-25"))
+	assert.False(t, w.Contains(""))
+}
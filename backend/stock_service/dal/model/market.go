@@ -2,12 +2,16 @@ package model
 
 import "time"
 
+// MarketSectorDaily is one sector in an intraday ranking snapshot
 type MarketSectorDaily struct {
 	ID            uint      `gorm:"primaryKey"`
-	Date          string    `gorm:"index;type:varchar(10)"` // YYYY-MM-DD
-	Time          string    `gorm:"type:varchar(8)"`        // HH:MM:SS
-	SectorCode    string    `gorm:"index;type:varchar(20)"`
+	Date          string    `gorm:"index;uniqueIndex:idx_sector_snapshot;type:varchar(10)"` // YYYY-MM-DD
+	Time          string    `gorm:"uniqueIndex:idx_sector_snapshot;type:varchar(8)"`        // HH:MM:SS
+	SectorCode    string    `gorm:"index;uniqueIndex:idx_sector_snapshot;type:varchar(20)"`
+	Type          string    `gorm:"type:varchar(10)"` // concept, industry
 	Name          string    `gorm:"type:varchar(50)"`
+	Rank          int       `gorm:"type:int"` // 1-based rank by change percent within Type
+	Total         int       `gorm:"type:int"` // Number of sectors of Type in the snapshot
 	ChangePercent float64   `gorm:"type:decimal(10,2)"`
 	NetInflow     float64   `gorm:"type:decimal(20,2)"`
 	TopStockName  string    `gorm:"type:varchar(50)"`
@@ -20,8 +24,10 @@ type MarketLimitUpSummary struct {
 	Date           string    `gorm:"uniqueIndex;type:varchar(10)"`
 	LimitUpCount   int       `gorm:"type:int"`
 	BrokenCount    int       `gorm:"type:int"`
+	LimitDownCount int       `gorm:"type:int"`
 	HighestBoard   int       `gorm:"type:int"` // 最高连板数
-	SentimentScore float64   `gorm:"type:decimal(5,2)"`
+	SentimentScore float64   `gorm:"type:decimal(5,2)"` // Market temperature, 0-100
+	Components     string    `gorm:"type:text"`         // JSON temperature breakdown
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -38,3 +44,20 @@ type MarketLimitUpDetail struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
+
+// MarketBreadthDaily is the closing advance/decline picture of one trading day
+type MarketBreadthDaily struct {
+	ID        uint    `gorm:"primaryKey"`
+	Date      string  `gorm:"uniqueIndex;type:varchar(10)"`
+	Advance   int     `gorm:"type:int"`
+	Decline   int     `gorm:"type:int"`
+	Flat      int     `gorm:"type:int"`
+	Suspended int     `gorm:"type:int"`
+	LimitUp   int     `gorm:"type:int"`
+	LimitDown int     `gorm:"type:int"`
+	Amount    float64 `gorm:"type:decimal(20,2)"` // Total turnover (CNY)
+	Buckets   string  `gorm:"type:varchar(255)"`  // JSON counts in breadth.BucketLabels order
+	Boards    string  `gorm:"type:text"`          // JSON breakdowns in breadth.Boards order
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Security is one row of the security master: every A-share stock, ETF and index
+type Security struct {
+	Code        string `gorm:"primaryKey;type:varchar(10)"` // Prefixed, e.g. sh600519
+	Symbol      string `gorm:"index;type:varchar(6)"`       // 600519
+	Exchange    string `gorm:"type:varchar(2)"`             // SH, SZ, BJ
+	Board       string `gorm:"type:varchar(10)"`            // main, star, chinext, bse, etf, index
+	Type        string `gorm:"index;type:varchar(10)"`      // stock, etf, index
+	Name        string `gorm:"index;type:varchar(50)"`
+	Pinyin      string `gorm:"type:varchar(100)"` // Initials, e.g. gzmt; comma-separated variants for polyphones
+	ListingDate string `gorm:"type:varchar(10)"`  // YYYY-MM-DD
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
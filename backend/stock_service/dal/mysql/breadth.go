@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveMarketBreadth upserts the breadth of one day by date
+func SaveMarketBreadth(b *model.MarketBreadthDaily) error {
+	if DB == nil {
+		return fmt.Errorf("mysql not initialized")
+	}
+	return DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"advance", "decline", "flat", "suspended", "limit_up", "limit_down", "amount", "buckets", "boards", "updated_at"}),
+	}).Create(b).Error
+}
+
+// LoadMarketBreadth returns the breadth stored for date (YYYY-MM-DD), nil when there is none
+func LoadMarketBreadth(date string) (*model.MarketBreadthDaily, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	var b model.MarketBreadthDaily
+	err := DB.Where("date = ?", date).First(&b).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// LoadMarketBreadthBefore returns up to n stored days before date, newest first
+func LoadMarketBreadthBefore(date string, n int) ([]*model.MarketBreadthDaily, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	var list []*model.MarketBreadthDaily
+	err := DB.Where("date < ?", date).Order("date DESC").Limit(n).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
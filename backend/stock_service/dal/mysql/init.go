@@ -30,6 +30,8 @@ func Init() {
 		&model.MarketSectorDaily{},
 		&model.MarketLimitUpSummary{},
 		&model.MarketLimitUpDetail{},
+		&model.Security{},
+		&model.MarketBreadthDaily{},
 	)
 	if err != nil {
 		fmt.Printf("Warning: Failed to auto migrate: %v\n", err)
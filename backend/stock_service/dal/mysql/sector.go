@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"fmt"
+
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"gorm.io/gorm/clause"
+)
+
+// SaveSectorSnapshot stores the rows of one ranking snapshot. A row already stored
+// for the same date, time and sector is overwritten.
+func SaveSectorSnapshot(rows []*model.MarketSectorDaily) error {
+	if DB == nil {
+		return fmt.Errorf("mysql not initialized")
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "date"}, {Name: "time"}, {Name: "sector_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"type", "name", "rank", "total", "change_percent", "net_inflow", "top_stock_name", "updated_at"}),
+	}).CreateInBatches(rows, 500).Error
+}
+
+// LoadSectorTimeline returns the snapshots of one sector on date (YYYY-MM-DD), earliest first
+func LoadSectorTimeline(sectorCode, date string) ([]*model.MarketSectorDaily, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	var list []*model.MarketSectorDaily
+	err := DB.Where("date = ? AND sector_code = ?", date, sectorCode).Order("time").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// LoadSectorCloses returns the last snapshot of each of dates for the rankType
+// ranking, which is the closing ranking for a completed day
+func LoadSectorCloses(rankType string, dates []string) ([]*model.MarketSectorDaily, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	if len(dates) == 0 {
+		return nil, nil
+	}
+	last := DB.Model(&model.MarketSectorDaily{}).
+		Select("date, MAX(time)").
+		Where("type = ? AND date IN ?", rankType, dates).
+		Group("date")
+
+	var list []*model.MarketSectorDaily
+	err := DB.Where("type = ? AND (date, time) IN (?)", rankType, last).Order("date, `rank`").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
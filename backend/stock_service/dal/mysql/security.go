@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"fmt"
+	"time"
+
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LoadSecurities returns the whole security master
+func LoadSecurities() ([]*model.Security, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	var list []*model.Security
+	if err := DB.Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SaveSecurities upserts the security master by code, stamping every row with refreshedAt.
+// With prune, the list is the whole universe and the rows it no longer has (delisted)
+// are deleted in the same transaction.
+func SaveSecurities(list []*model.Security, refreshedAt time.Time, prune bool) error {
+	if DB == nil {
+		return fmt.Errorf("mysql not initialized")
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return DB.Transaction(func(tx *gorm.DB) error {
+		for _, s := range list {
+			s.UpdatedAt = refreshedAt
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"symbol", "exchange", "board", "type", "name", "pinyin", "listing_date", "updated_at"}),
+		}).CreateInBatches(list, 500).Error; err != nil {
+			return err
+		}
+		if !prune {
+			return nil
+		}
+		return tx.Where("updated_at < ?", refreshedAt).Delete(&model.Security{}).Error
+	})
+}
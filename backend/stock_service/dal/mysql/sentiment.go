@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"fmt"
+
+	"stock_assistant/backend/stock_service/dal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveLimitUpDay stores the limit-up pool of summary.Date. The summary is upserted
+// by date and the details of that date are replaced, so rerunning a day is idempotent.
+func SaveLimitUpDay(summary *model.MarketLimitUpSummary, details []*model.MarketLimitUpDetail) error {
+	if DB == nil {
+		return fmt.Errorf("mysql not initialized")
+	}
+	return DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"limit_up_count", "broken_count", "limit_down_count", "highest_board", "sentiment_score", "components", "updated_at"}),
+		}).Create(summary).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("date = ?", summary.Date).Delete(&model.MarketLimitUpDetail{}).Error; err != nil {
+			return err
+		}
+		if len(details) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(details, 500).Error
+	})
+}
+
+// LoadLimitUpSummaries returns the stored days between start and end (YYYY-MM-DD, inclusive), oldest first
+func LoadLimitUpSummaries(start, end string) ([]*model.MarketLimitUpSummary, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	var list []*model.MarketLimitUpSummary
+	err := DB.Where("date >= ? AND date <= ?", start, end).Order("date").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// LoadLimitUpDetails returns the stored limit-up stocks of date (YYYY-MM-DD)
+func LoadLimitUpDetails(date string) ([]*model.MarketLimitUpDetail, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("mysql not initialized")
+	}
+	var list []*model.MarketLimitUpDetail
+	if err := DB.Where("date = ?", date).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
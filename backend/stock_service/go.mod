@@ -6,11 +6,13 @@ require (
 	github.com/cloudwego/gopkg v0.1.8
 	github.com/cloudwego/kitex v0.15.4
 	github.com/cloudwego/kitex/pkg/protocol/bthrift v0.0.0-20260112072316-5cf426cf9e1b
+	github.com/mozillazg/go-pinyin v0.21.0
 	github.com/redis/go-redis/v9 v9.17.2
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/text v0.33.0
 	gorm.io/driver/mysql v1.6.0
 	gorm.io/gorm v1.31.1
+	stock_assistant/backend/common v0.0.0
 )
 
 require (
@@ -60,3 +62,5 @@ require (
 )
 
 replace github.com/apache/thrift => github.com/apache/thrift v0.13.0
+
+replace stock_assistant/backend/common => ../common
@@ -4,11 +4,30 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"math"
 	"time"
 
+	"stock_assistant/backend/common/calendar"
+	"stock_assistant/backend/common/symbol"
+	"stock_assistant/backend/stock_service/biz/breadth"
+	"stock_assistant/backend/stock_service/biz/collector"
+	"stock_assistant/backend/stock_service/biz/corporate"
+	"stock_assistant/backend/stock_service/biz/earnings"
+	"stock_assistant/backend/stock_service/biz/financial"
+	"stock_assistant/backend/stock_service/biz/ladder"
+	"stock_assistant/backend/stock_service/biz/provider"
 	"stock_assistant/backend/stock_service/biz/provider/eastmoney"
 	"stock_assistant/backend/stock_service/biz/provider/sentiment"
 	"stock_assistant/backend/stock_service/biz/provider/sina"
+	"stock_assistant/backend/stock_service/biz/provider/tencent"
+	"stock_assistant/backend/stock_service/biz/sector"
+	"stock_assistant/backend/stock_service/biz/security"
+	"stock_assistant/backend/stock_service/biz/temperature"
+	"stock_assistant/backend/stock_service/biz/valuation"
+	"stock_assistant/backend/stock_service/biz/window"
+	"stock_assistant/backend/stock_service/dal/model"
+	"stock_assistant/backend/stock_service/dal/mysql"
 	"stock_assistant/backend/stock_service/dal/redis"
 	stock "stock_assistant/backend/stock_service/kitex_gen/stock"
 	"sort"
@@ -17,17 +36,37 @@ import (
 
 // StockServiceImpl implements the last service interface defined in the IDL.
 type StockServiceImpl struct {
-	sinaClient      *sina.Client
-	eastMoneyClient *eastmoney.Client
-	sentimentClient *sentiment.Client
+	quoteChain         *provider.QuoteChain
+	eastMoneyClient    *eastmoney.Client
+	sentimentClient    *sentiment.Client
+	securityMaster     *security.Master
+	sentimentCollector *collector.SentimentCollector
+	sectorCollector    *collector.SectorCollector
+	breadthCollector   *collector.BreadthCollector
 }
 
+// temperatureConfigPath holds the market temperature weights, relative to the working directory
+const temperatureConfigPath = "conf/temperature.json"
+
 // NewStockServiceImpl creates a new StockServiceImpl
 func NewStockServiceImpl() *StockServiceImpl {
+	eastMoneyClient := eastmoney.NewClient()
+	sentimentClient := sentiment.NewClient()
+
+	temperatureConfig, err := temperature.LoadConfig(temperatureConfigPath)
+	if err != nil {
+		log.Printf("Failed to load %s: %v, using the default temperature weights", temperatureConfigPath, err)
+	}
+
 	return &StockServiceImpl{
-		sinaClient:      sina.NewClient(),
-		eastMoneyClient: eastmoney.NewClient(),
-		sentimentClient: sentiment.NewClient(),
+		// Sina first (fastest), then EastMoney and Tencent as fallbacks
+		quoteChain:         provider.NewQuoteChain(sina.NewClient(), eastMoneyClient, tencent.NewClient()),
+		eastMoneyClient:    eastMoneyClient,
+		sentimentClient:    sentimentClient,
+		securityMaster:     security.NewMaster(eastMoneyClient),
+		sentimentCollector: collector.NewSentimentCollector(sentimentClient, temperatureConfig),
+		sectorCollector:    collector.NewSectorCollector(eastMoneyClient, collector.DefaultSectorInterval),
+		breadthCollector:   collector.NewBreadthCollector(eastMoneyClient),
 	}
 }
 
@@ -36,8 +75,13 @@ func (s *StockServiceImpl) GetRealtime(ctx context.Context, req *stock.GetRealti
 	if req.Code == "" {
 		return &stock.GetRealtimeResponse{}, nil
 	}
+	// Reject malformed codes here, they must not count as feed failures in the chain
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
 
-	info, err := s.sinaClient.GetStockInfo(ctx, req.Code)
+	info, err := s.quoteChain.GetStockInfo(ctx, sec.Prefixed())
 	if err != nil {
 		// Log error and return empty response or specific error code
 		// For now, return error
@@ -49,6 +93,57 @@ func (s *StockServiceImpl) GetRealtime(ctx context.Context, req *stock.GetRealti
 	}, nil
 }
 
+// maxRealtimeBatch caps the number of codes accepted by GetRealtimeBatch
+const maxRealtimeBatch = 200
+
+// GetRealtimeBatch implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetRealtimeBatch(ctx context.Context, req *stock.GetRealtimeBatchRequest) (resp *stock.GetRealtimeBatchResponse, err error) {
+	// Trim and dedupe while keeping the request order
+	seen := make(map[string]bool, len(req.Codes))
+	codes := make([]string, 0, len(req.Codes))
+	for _, code := range req.Codes {
+		code = strings.TrimSpace(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+
+	resp = &stock.GetRealtimeBatchResponse{
+		Stocks: make([]*stock.StockInfo, 0, len(codes)),
+		Errors: make(map[string]string),
+	}
+	if len(codes) == 0 {
+		return resp, nil
+	}
+	if len(codes) > maxRealtimeBatch {
+		return nil, fmt.Errorf("too many codes: %d, at most %d per request", len(codes), maxRealtimeBatch)
+	}
+
+	// Malformed codes are answered right away instead of being sent down the chain
+	valid := make([]string, 0, len(codes))
+	for _, code := range codes {
+		if _, err := symbol.Parse(code); err != nil {
+			resp.Errors[code] = err.Error()
+			continue
+		}
+		valid = append(valid, code)
+	}
+
+	infos, errs := s.quoteChain.GetStockInfos(ctx, valid)
+	for _, code := range valid {
+		if info, ok := infos[code]; ok {
+			resp.Stocks = append(resp.Stocks, info)
+			continue
+		}
+		if e, ok := errs[code]; ok {
+			resp.Errors[code] = e.Error()
+		}
+	}
+	return resp, nil
+}
+
 // GetFinancialReport implements the StockServiceImpl interface.
 func (s *StockServiceImpl) GetFinancialReport(ctx context.Context, req *stock.GetFinancialReportRequest) (resp *stock.GetFinancialReportResponse, err error) {
 	if req.Code == "" {
@@ -65,6 +160,480 @@ func (s *StockServiceImpl) GetFinancialReport(ctx context.Context, req *stock.Ge
 	}, nil
 }
 
+const (
+	defaultStatementPeriods = 8
+	maxStatementPeriods     = 40
+)
+
+// GetFinancialStatements implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetFinancialStatements(ctx context.Context, req *stock.GetFinancialStatementsRequest) (resp *stock.GetFinancialStatementsResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetFinancialStatementsResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	view := req.View
+	switch view {
+	case "":
+		view = financial.ViewReport
+	case financial.ViewReport, financial.ViewQuarter, financial.ViewAnnual, financial.ViewTTM:
+	default:
+		return nil, fmt.Errorf("invalid view %q, expected report, quarter, annual or ttm", req.View)
+	}
+	periods := int(req.Periods)
+	if periods <= 0 {
+		periods = defaultStatementPeriods
+	}
+	if periods > maxStatementPeriods {
+		periods = maxStatementPeriods
+	}
+
+	// Reports are published a few times a year, an hour is plenty
+	cacheKey := fmt.Sprintf("stock:financial:%s:%s:%d", sec.Prefixed(), view, periods)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetFinancialStatementsResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	name, reports, err := s.eastMoneyClient.GetFinancialStatements(ctx, sec.Suffixed(), financial.ReportsNeeded(view, periods))
+	if err != nil {
+		return nil, err
+	}
+	list, err := financial.View(reports, view, periods)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetFinancialStatementsResponse{
+		Code:    sec.Prefixed(),
+		Name:    name,
+		View:    view,
+		Periods: list,
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+const (
+	defaultValuationYears = 5
+	maxValuationYears     = 10
+)
+
+// GetValuation implements the StockServiceImpl interface.
+// The industry median is best effort, the bands are returned without it.
+func (s *StockServiceImpl) GetValuation(ctx context.Context, req *stock.GetValuationRequest) (resp *stock.GetValuationResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetValuationResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	years := int(req.Years)
+	if years <= 0 {
+		years = defaultValuationYears
+	}
+	if years > maxValuationYears {
+		years = maxValuationYears
+	}
+
+	// Valuations are published once a day after the close
+	cacheKey := fmt.Sprintf("stock:valuation:%s:%d", sec.Prefixed(), years)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetValuationResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	start := calendar.Now().AddDate(-years, 0, 0).Format(calendar.DateLayout)
+	history, err := s.eastMoneyClient.GetStockValuation(ctx, sec.Symbol, start)
+	if err != nil {
+		return nil, err
+	}
+
+	var industry []*stock.ValuationDay
+	if history.IndustryCode != "" {
+		latest := history.Days[len(history.Days)-1].Date
+		industry, err = s.eastMoneyClient.GetIndustryValuation(ctx, history.IndustryCode, latest)
+		if err != nil {
+			log.Printf("GetValuation: industry %s of %s unavailable: %v", history.Industry, sec.Prefixed(), err)
+		}
+	}
+
+	pe, pb, ps := valuation.Bands(history.Days, industry)
+	resp = &stock.GetValuationResponse{
+		Code:     sec.Prefixed(),
+		Name:     history.Name,
+		Industry: history.Industry,
+		Years:    int32(years),
+		Days:     history.Days,
+		PeTtm:    pe,
+		Pb:       pb,
+		PsTtm:    ps,
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetEarningsCalendar implements the StockServiceImpl interface.
+// The previews and the express reports are best effort, the disclosures are returned without them.
+func (s *StockServiceImpl) GetEarningsCalendar(ctx context.Context, req *stock.GetEarningsCalendarRequest) (resp *stock.GetEarningsCalendarResponse, err error) {
+	code, scope := "", "market"
+	if req.Code != "" {
+		sec, err := symbol.Parse(req.Code)
+		if err != nil {
+			return nil, err
+		}
+		code, scope = sec.Symbol, sec.Prefixed()
+	}
+	w, err := window.New(req.Start, req.End, code != "", calendar.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("stock:earnings:%s:%s:%s", scope, w.Start, w.End)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetEarningsCalendarResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	disclosures, err := s.eastMoneyClient.GetEarningsDisclosures(ctx, code, earnings.FirstPeriod(w), w.End)
+	if err != nil {
+		return nil, err
+	}
+	previews, err := s.eastMoneyClient.GetEarningsPreviews(ctx, code, w.Start, w.End)
+	if err != nil {
+		log.Printf("GetEarningsCalendar: previews of %s unavailable: %v", scope, err)
+	}
+	expresses, err := s.eastMoneyClient.GetEarningsExpresses(ctx, code, w.Start, w.End)
+	if err != nil {
+		log.Printf("GetEarningsCalendar: express reports of %s unavailable: %v", scope, err)
+	}
+	resp = earnings.Collect(w, disclosures, previews, expresses)
+
+	// Schedules move and previews come out during the day
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetShareUnlocks implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetShareUnlocks(ctx context.Context, req *stock.GetShareUnlocksRequest) (resp *stock.GetShareUnlocksResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetShareUnlocksResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	w, err := window.New(req.Start, req.End, true, calendar.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("stock:unlocks:%s:%s:%s", sec.Prefixed(), w.Start, w.End)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetShareUnlocksResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	unlocks, err := s.eastMoneyClient.GetShareUnlocks(ctx, sec.Symbol, w.Start, w.End)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetShareUnlocksResponse{
+		Code:    sec.Prefixed(),
+		Start:   w.Start,
+		End:     w.End,
+		Unlocks: corporate.Unlocks(w, unlocks),
+	}
+
+	// Upcoming unlocks are revalued at every close
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetDividends implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetDividends(ctx context.Context, req *stock.GetDividendsRequest) (resp *stock.GetDividendsResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetDividendsResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	w, err := window.New(req.Start, req.End, true, calendar.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("stock:dividends:%s:%s:%s", sec.Prefixed(), w.Start, w.End)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetDividendsResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	plans, err := s.eastMoneyClient.GetStockDividends(ctx, sec.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetDividendsResponse{
+		Code:      sec.Prefixed(),
+		Start:     w.Start,
+		End:       w.End,
+		Dividends: corporate.Dividends(w, plans),
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetCorporateActionCalendar implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetCorporateActionCalendar(ctx context.Context, req *stock.GetCorporateActionCalendarRequest) (resp *stock.GetCorporateActionCalendarResponse, err error) {
+	w, err := window.New(req.Start, req.End, false, calendar.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("market:corporate:%s:%s", w.Start, w.End)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetCorporateActionCalendarResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	unlocks, err := s.eastMoneyClient.GetShareUnlocks(ctx, "", w.Start, w.End)
+	if err != nil {
+		return nil, err
+	}
+	plans, err := s.eastMoneyClient.GetDividends(ctx, w.Start, w.End)
+	if err != nil {
+		log.Printf("GetCorporateActionCalendar: dividends unavailable: %v", err)
+	}
+	resp = &stock.GetCorporateActionCalendarResponse{
+		Start:     w.Start,
+		End:       w.End,
+		Unlocks:   corporate.Unlocks(w, unlocks),
+		Dividends: corporate.Dividends(w, plans),
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetKLine implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetKLine(ctx context.Context, req *stock.GetKLineRequest) (resp *stock.GetKLineResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetKLineResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	name, bars, err := s.eastMoneyClient.GetKLine(ctx, sec.Prefixed(), eastmoney.KLineQuery{
+		Period:    req.Period,
+		Adjust:    req.Adjust,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Limit:     int(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stock.GetKLineResponse{
+		Code: sec.Prefixed(),
+		Name: name,
+		Bars: bars,
+	}, nil
+}
+
+// GetIntradayTrend implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetIntradayTrend(ctx context.Context, req *stock.GetIntradayTrendRequest) (resp *stock.GetIntradayTrendResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetIntradayTrendResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	trend, err := s.eastMoneyClient.GetIntradayTrend(ctx, sec.Prefixed(), req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stock.GetIntradayTrendResponse{
+		Code:      sec.Prefixed(),
+		Name:      trend.Name,
+		Date:      trend.Date,
+		PrevClose: trend.PrevClose,
+		Points:    trend.Points,
+	}, nil
+}
+
+const (
+	defaultFundFlowDays = 20
+	maxFundFlowDays     = 120
+)
+
+// GetStockFundFlow implements the StockServiceImpl interface.
+// The intraday flow is best effort, the daily history is returned without it.
+func (s *StockServiceImpl) GetStockFundFlow(ctx context.Context, req *stock.GetStockFundFlowRequest) (resp *stock.GetStockFundFlowResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetStockFundFlowResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	days := int(req.Days)
+	if days <= 0 {
+		days = defaultFundFlowDays
+	}
+	if days > maxFundFlowDays {
+		days = maxFundFlowDays
+	}
+
+	// The session is part of the key so a new session never reads yesterday's flow
+	session := calendar.LatestTradingDay(calendar.Now()).Format(calendar.DateLayout)
+	cacheKey := fmt.Sprintf("stock:fundflow:%s:%s:%d", sec.Prefixed(), session, days)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetStockFundFlowResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	name, flows, err := s.eastMoneyClient.GetStockFundFlowDays(ctx, sec.Prefixed(), days)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetStockFundFlowResponse{
+		Code: sec.Prefixed(),
+		Name: name,
+		Days: flows,
+	}
+
+	date, minutes, err := s.eastMoneyClient.GetStockFundFlowIntraday(ctx, sec.Prefixed())
+	if err != nil {
+		log.Printf("GetStockFundFlow: intraday flow of %s unavailable: %v", sec.Prefixed(), err)
+	} else {
+		resp.IntradayDate = date
+		resp.Intraday = minutes
+	}
+
+	// Set Redis Cache, money flow moves every minute while the session is open
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(session, time.Minute))
+	}
+
+	return resp, nil
+}
+
+// defaultIndexCodes is the index set of the market summary page when the request names none:
+// 上证指数, 深证成指, 创业板指, 科创50, 北证50
+var defaultIndexCodes = []string{"sh000001", "sz399001", "sz399006", "sh000688", "bj899050"}
+
+// GetIndexOverview implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetIndexOverview(ctx context.Context, req *stock.GetIndexOverviewRequest) (resp *stock.GetIndexOverviewResponse, err error) {
+	codes := req.Codes
+	if len(codes) == 0 {
+		codes = defaultIndexCodes
+	}
+
+	// A past session is rebuilt from the daily closes, the running or latest one is live
+	if req.Date != "" {
+		if _, err := time.Parse(calendar.DateLayout, req.Date); err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+		}
+		if req.Date < calendar.LatestTradingDay(calendar.Now()).Format(calendar.DateLayout) {
+			cacheKey := fmt.Sprintf("market:index:closes:%s:%s", req.Date, strings.Join(codes, ","))
+			if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+				var indices []*stock.IndexQuote
+				if err := json.Unmarshal([]byte(cached), &indices); err == nil {
+					return &stock.GetIndexOverviewResponse{Indices: indices}, nil
+				}
+			}
+
+			indices, err := s.eastMoneyClient.GetIndexCloses(ctx, codes, req.Date)
+			if err != nil {
+				return nil, err
+			}
+			if bytes, err := json.Marshal(indices); err == nil {
+				_ = redis.Set(ctx, cacheKey, string(bytes), closedDayCacheTTL)
+			}
+			return &stock.GetIndexOverviewResponse{Indices: indices}, nil
+		}
+	}
+
+	// Try Redis Cache first
+	cacheKey := fmt.Sprintf("market:index:overview:%s", strings.Join(codes, ","))
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var indices []*stock.IndexQuote
+		if err := json.Unmarshal([]byte(cached), &indices); err == nil {
+			return &stock.GetIndexOverviewResponse{Indices: indices}, nil
+		}
+	}
+
+	indices, err := s.eastMoneyClient.GetIndexQuotes(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set Redis Cache (TTL 10s), index quotes move every tick
+	if bytes, err := json.Marshal(indices); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), 10*time.Second)
+	}
+
+	return &stock.GetIndexOverviewResponse{Indices: indices}, nil
+}
+
+// SearchSecurities implements the StockServiceImpl interface.
+func (s *StockServiceImpl) SearchSecurities(ctx context.Context, req *stock.SearchSecuritiesRequest) (resp *stock.SearchSecuritiesResponse, err error) {
+	resp = &stock.SearchSecuritiesResponse{
+		Securities: make([]*stock.SecurityInfo, 0),
+	}
+	for _, sec := range s.securityMaster.Search(req.Query, int(req.Limit)) {
+		resp.Securities = append(resp.Securities, &stock.SecurityInfo{
+			Code:        sec.Code,
+			Symbol:      sec.Symbol,
+			Exchange:    sec.Exchange,
+			Board:       sec.Board,
+			Type:        sec.Type,
+			Name:        sec.Name,
+			Pinyin:      sec.Pinyin,
+			ListingDate: sec.ListingDate,
+		})
+	}
+	return resp, nil
+}
+
 // GetMarketSectors implements the StockServiceImpl interface.
 func (s *StockServiceImpl) GetMarketSectors(ctx context.Context, req *stock.GetMarketSectorsRequest) (resp *stock.GetMarketSectorsResponse, err error) {
 	limit := int(req.Limit)
@@ -118,46 +687,973 @@ func (s *StockServiceImpl) GetMarketSectors(ctx context.Context, req *stock.GetM
 	return &stock.GetMarketSectorsResponse{Sectors: thriftSectors}, nil
 }
 
-// GetLimitUpPool implements the StockServiceImpl interface.
-func (s *StockServiceImpl) GetLimitUpPool(ctx context.Context, req *stock.GetLimitUpPoolRequest) (resp *stock.GetLimitUpPoolResponse, err error) {
-	// Try Redis Cache first (only if date is not specified or is today)
-	// For simplicity, we only cache the "current" pool
-	cacheKey := "market:limit_up:pool"
-
-	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
-		var thriftStocks []*stock.LimitUpStock
-		if err := json.Unmarshal([]byte(cached), &thriftStocks); err == nil {
-			return &stock.GetLimitUpPoolResponse{Stocks: thriftStocks}, nil
-		}
+// GetSectorTimeline implements the StockServiceImpl interface.
+// It reads the snapshots stored by the sector collector.
+func (s *StockServiceImpl) GetSectorTimeline(ctx context.Context, req *stock.GetSectorTimelineRequest) (resp *stock.GetSectorTimelineResponse, err error) {
+	if req.SectorCode == "" {
+		return nil, fmt.Errorf("sector_code is required")
+	}
+	// Unlike the pools, the timeline of a running session is useful
+	date := req.Date
+	if date == "" {
+		date = calendar.LatestTradingDay(calendar.Now()).Format(calendar.DateLayout)
+	}
+	if _, err := time.Parse(calendar.DateLayout, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
 	}
 
-	// Note: req.Date is currently ignored by the simple implementation, but could be passed if upgraded.
-	pool, err := s.sentimentClient.GetLimitUpPool(ctx)
+	rows, err := mysql.LoadSectorTimeline(req.SectorCode, date)
 	if err != nil {
 		return nil, err
 	}
 
-	var thriftStocks []*stock.LimitUpStock
-	for _, item := range pool {
-		thriftStocks = append(thriftStocks, &stock.LimitUpStock{
-			Code:          item.Code,
-			Name:          item.Name,
+	resp = &stock.GetSectorTimelineResponse{
+		SectorCode: req.SectorCode,
+		Date:       date,
+		Points:     make([]*stock.SectorTimelinePoint, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.Name = row.Name
+		resp.Type = row.Type
+		resp.Points = append(resp.Points, &stock.SectorTimelinePoint{
+			Time:          row.Time,
+			Rank:          int32(row.Rank),
+			Total:         int32(row.Total),
+			ChangePercent: row.ChangePercent,
+			NetInflow:     row.NetInflow,
+			TopStockName:  row.TopStockName,
+		})
+	}
+	return resp, nil
+}
+
+const (
+	defaultRotationDays = 5
+	maxRotationDays     = 20
+)
+
+// GetSectorRotation implements the StockServiceImpl interface.
+// It ranks the sectors over the last trading days from the closing snapshots
+// of the sector collector, the running session uses its latest snapshot, or the
+// live ranking when none is stored yet. A past date ends the window at that session.
+func (s *StockServiceImpl) GetSectorRotation(ctx context.Context, req *stock.GetSectorRotationRequest) (resp *stock.GetSectorRotationResponse, err error) {
+	days := int(req.Days)
+	if days <= 0 {
+		days = defaultRotationDays
+	}
+	if days > maxRotationDays {
+		days = maxRotationDays
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	rankType := req.Type
+	if rankType == "" {
+		rankType = "concept"
+	}
+	if rankType != "concept" && rankType != "industry" {
+		return nil, fmt.Errorf("invalid type %q, expected concept or industry", rankType)
+	}
+
+	now := calendar.Now()
+	session := calendar.LatestTradingDay(now)
+	day := session
+	if req.Date != "" {
+		end, err := time.ParseInLocation(calendar.DateLayout, req.Date, calendar.Location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+		}
+		// The session of that day once it has closed, a later date keeps the latest session
+		if end = calendar.CloseTime(end); end.Before(session) {
+			day = calendar.LatestTradingDay(end)
+		}
+	}
+	dates := make([]string, days)
+	for i := days - 1; i >= 0; i-- {
+		dates[i] = day.Format(calendar.DateLayout)
+		day = calendar.PrevTradingDay(day)
+	}
+
+	byDate := make(map[string][]*model.MarketSectorDaily)
+	rows, err := mysql.LoadSectorCloses(rankType, dates)
+	if err != nil {
+		// Without history the rotation degrades to the live ranking
+		log.Printf("GetSectorRotation: load snapshots failed: %v", err)
+	}
+	for _, row := range rows {
+		byDate[row.Date] = append(byDate[row.Date], row)
+	}
+
+	// Only the running or latest session can fall back to the live ranking
+	latest := dates[len(dates)-1]
+	if len(byDate[latest]) == 0 && latest == session.Format(calendar.DateLayout) {
+		live, err := s.eastMoneyClient.GetSectorRank(ctx, rankType, collector.SectorRankLimit)
+		if err != nil {
+			return nil, err
+		}
+		byDate[latest] = collector.BuildSectorSnapshot(now, rankType, live)
+	}
+
+	rotation := sector.Compute(dates, byDate)
+	if len(rotation) > limit {
+		rotation = rotation[:limit]
+	}
+
+	resp = &stock.GetSectorRotationResponse{
+		Dates:   dates,
+		Sectors: make([]*stock.SectorRotationItem, 0, len(rotation)),
+	}
+	for _, r := range rotation {
+		ranks := make([]int32, len(r.Ranks))
+		for i, rank := range r.Ranks {
+			ranks[i] = int32(rank)
+		}
+		resp.Sectors = append(resp.Sectors, &stock.SectorRotationItem{
+			Code:                r.Code,
+			Name:                r.Name,
+			Type:                r.Type,
+			Ranks:               ranks,
+			LatestRank:          int32(r.LatestRank),
+			Total:               int32(r.Total),
+			CumulativeChange:    r.CumulativeChange,
+			CumulativeNetInflow: r.CumulativeNetInflow,
+			Top10Streak:         int32(r.TopStreak),
+			MomentumScore:       r.Momentum,
+			Trend:               r.Trend,
+		})
+	}
+	return resp, nil
+}
+
+const (
+	defaultSectorFlowDays = 10
+	maxSectorFlowDays     = 20
+)
+
+// GetSectorFundFlow implements the StockServiceImpl interface.
+// The ranking over the period and the latest day of the history come from the live
+// fund flow ranking, the earlier days from the closing snapshots of the sector collector.
+func (s *StockServiceImpl) GetSectorFundFlow(ctx context.Context, req *stock.GetSectorFundFlowRequest) (resp *stock.GetSectorFundFlowResponse, err error) {
+	rankType := req.Type
+	if rankType == "" {
+		rankType = "concept"
+	}
+	if rankType != "concept" && rankType != "industry" {
+		return nil, fmt.Errorf("invalid type %q, expected concept or industry", rankType)
+	}
+	period := req.Period
+	if period == "" {
+		period = "today"
+	}
+	if period != "today" && period != "5d" && period != "10d" {
+		return nil, fmt.Errorf("invalid period %q, expected today, 5d or 10d", period)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	days := int(req.HistoryDays)
+	if days <= 0 {
+		days = defaultSectorFlowDays
+	}
+	if days > maxSectorFlowDays {
+		days = maxSectorFlowDays
+	}
+
+	now := calendar.Now()
+	dates := make([]string, days)
+	day := calendar.LatestTradingDay(now)
+	for i := days - 1; i >= 0; i-- {
+		dates[i] = day.Format(calendar.DateLayout)
+		day = calendar.PrevTradingDay(day)
+	}
+	latest := dates[len(dates)-1]
+
+	var live []*eastmoney.SectorFundFlow
+	cacheKey := fmt.Sprintf("market:sector:fundflow:%s:%s:%s", rankType, period, latest)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		_ = json.Unmarshal([]byte(cached), &live)
+	}
+	if len(live) == 0 {
+		// The whole ranking, min_streak may pick sectors far down the list
+		live, err = s.eastMoneyClient.GetSectorFundFlowRank(ctx, rankType, period, collector.SectorRankLimit)
+		if err != nil {
+			return nil, err
+		}
+		if bytes, err := json.Marshal(live); err == nil {
+			_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(latest, time.Minute))
+		}
+	}
+
+	byDate := make(map[string][]*model.MarketSectorDaily)
+	rows, err := mysql.LoadSectorCloses(rankType, dates[:len(dates)-1])
+	if err != nil {
+		// Without history only the latest day is known
+		log.Printf("GetSectorFundFlow: load snapshots failed: %v", err)
+	}
+	for _, row := range rows {
+		byDate[row.Date] = append(byDate[row.Date], row)
+	}
+	for _, f := range live {
+		byDate[latest] = append(byDate[latest], &model.MarketSectorDaily{
+			Date:       latest,
+			SectorCode: f.Code,
+			NetInflow:  f.TodayNetInflow,
+		})
+	}
+	flows := sector.Flows(dates, byDate)
+
+	resp = &stock.GetSectorFundFlowResponse{
+		Type:    rankType,
+		Period:  period,
+		Dates:   dates,
+		Sectors: make([]*stock.SectorFundFlowItem, 0, limit),
+	}
+	for _, f := range live {
+		flow := flows[f.Code]
+		if flow.Streak < int(req.MinStreak) {
+			continue
+		}
+		resp.Sectors = append(resp.Sectors, &stock.SectorFundFlowItem{
+			Code:           f.Code,
+			Name:           f.Name,
+			Type:           rankType,
+			ChangePercent:  f.ChangePercent,
+			MainNetInflow:  f.MainNet,
+			MainNetRatio:   f.MainNetRatio,
+			TopStockName:   f.TopStockName,
+			DailyNetInflow: flow.NetInflows,
+			InflowStreak:   int32(flow.Streak),
+		})
+		if len(resp.Sectors) == limit {
+			break
+		}
+	}
+	return resp, nil
+}
+
+// GetLimitUpPool implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetLimitUpPool(ctx context.Context, req *stock.GetLimitUpPoolRequest) (resp *stock.GetLimitUpPoolResponse, err error) {
+	// No date means the latest completed session, not a session that is still running
+	date := calendar.DefaultDate(req.Date)
+	if _, err := time.Parse(calendar.DateLayout, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+	}
+	cacheKey := fmt.Sprintf("market:limit_up:pool:%s", date)
+
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var thriftStocks []*stock.LimitUpStock
+		if err := json.Unmarshal([]byte(cached), &thriftStocks); err == nil {
+			return &stock.GetLimitUpPoolResponse{Stocks: thriftStocks, Date: date}, nil
+		}
+	}
+
+	pool, err := s.sentimentClient.GetLimitUpPool(ctx, date)
+	past := date < calendar.LatestTradingDay(calendar.Now()).Format(calendar.DateLayout)
+	if err != nil && !past {
+		return nil, err
+	}
+
+	// The vendor only keeps recent sessions, older days come from the sentiment collector
+	if len(pool) == 0 && past {
+		if err != nil {
+			log.Printf("GetLimitUpPool: vendor pool of %s unavailable: %v", date, err)
+		}
+		details, err := mysql.LoadLimitUpDetails(date)
+		if err != nil {
+			return nil, err
+		}
+		if len(details) == 0 {
+			return nil, fmt.Errorf("no limit up pool stored for %s", date)
+		}
+		thriftStocks := make([]*stock.LimitUpStock, 0, len(details))
+		for _, d := range details {
+			thriftStocks = append(thriftStocks, &stock.LimitUpStock{
+				Code:        d.StockCode,
+				Name:        d.StockName,
+				LimitUpType: d.LimitUpType,
+				Reason:      d.Reason,
+				IsBroken:    d.IsBroken,
+				BoardCount:  int32(d.BoardCount),
+			})
+		}
+		if bytes, err := json.Marshal(thriftStocks); err == nil {
+			_ = redis.Set(ctx, cacheKey, string(bytes), closedDayCacheTTL)
+		}
+		return &stock.GetLimitUpPoolResponse{Stocks: thriftStocks, Date: date}, nil
+	}
+
+	var thriftStocks []*stock.LimitUpStock
+	for _, item := range pool {
+		thriftStocks = append(thriftStocks, &stock.LimitUpStock{
+			Code:          item.Code,
+			Name:          item.Name,
 			Price:         item.Price,
 			ChangePercent: item.ChangePercent,
 			LimitUpType:   item.LimitUpType,
 			Reason:        item.Reason,
 			IsBroken:      item.IsBroken,
+			FirstSealTime: item.FirstSealTime,
+			LastSealTime:  item.LastSealTime,
+			SealAmount:    item.SealAmount,
+			OpenCount:     int32(item.OpenCount),
+			TurnoverRate:  item.TurnoverRate,
+			BoardCount:    int32(item.BoardCount),
 		})
 	}
 
-	// Set Redis Cache (TTL 30s)
+	// A closed day never changes again, a running session is refreshed every 30s
 	if len(thriftStocks) > 0 {
 		if bytes, err := json.Marshal(thriftStocks); err == nil {
-			_ = redis.Set(ctx, cacheKey, string(bytes), 30*time.Second)
+			_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(date, 30*time.Second))
+		}
+	}
+
+	return &stock.GetLimitUpPoolResponse{Stocks: thriftStocks, Date: date}, nil
+}
+
+// GetLimitUpLadder implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetLimitUpLadder(ctx context.Context, req *stock.GetLimitUpLadderRequest) (resp *stock.GetLimitUpLadderResponse, err error) {
+	pool, err := s.GetLimitUpPool(ctx, &stock.GetLimitUpPoolRequest{Date: req.Date})
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := time.ParseInLocation(calendar.DateLayout, pool.Date, calendar.Location)
+	if err != nil {
+		return nil, err
+	}
+	prevDate := calendar.PrevTradingDay(day).Format(calendar.DateLayout)
+	prev, err := s.limitUpBoards(ctx, prevDate)
+	if err != nil {
+		// The ladder still stands without the promotion rates
+		log.Printf("GetLimitUpLadder: previous pool %s unavailable: %v", prevDate, err)
+		prevDate = ""
+	}
+
+	if err := s.fillConcepts(ctx, pool.Date, pool.Stocks); err != nil {
+		log.Printf("GetLimitUpLadder: concepts of %s unavailable: %v", pool.Date, err)
+	}
+
+	levels := ladder.Build(pool.Stocks, prev)
+	resp = &stock.GetLimitUpLadderResponse{
+		Date:     pool.Date,
+		PrevDate: prevDate,
+		Levels:   make([]*stock.LimitUpLadderLevel, 0, len(levels)),
+	}
+	for _, l := range levels {
+		resp.Levels = append(resp.Levels, &stock.LimitUpLadderLevel{
+			Height:        int32(l.Height),
+			Stocks:        l.Stocks,
+			PrevCount:     int32(l.PrevCount),
+			PromotedCount: int32(l.Promoted),
+			PromotionRate: l.PromotionRate,
+		})
+	}
+	return resp, nil
+}
+
+// fillConcepts sets the top concept board of each stock of the pool of date. The concepts
+// of the codes already looked up that day are cached, the pool grows during the session.
+func (s *StockServiceImpl) fillConcepts(ctx context.Context, date string, stocks []*stock.LimitUpStock) error {
+	cacheKey := fmt.Sprintf("market:limit_up:concepts:%s", date)
+	concepts := make(map[string]string)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		_ = json.Unmarshal([]byte(cached), &concepts)
+	}
+
+	var missing []string
+	for _, st := range stocks {
+		if _, ok := concepts[st.Code]; !ok {
+			missing = append(missing, st.Code)
+		}
+	}
+	if len(missing) > 0 {
+		boards, err := s.eastMoneyClient.GetConceptBoards(ctx, missing)
+		if err != nil {
+			return err
+		}
+		// A code without boards yet (a new listing, or one the vendor has not indexed) is
+		// looked up again on the next call instead of being cached as having no concept
+		found := false
+		for _, st := range stocks {
+			if _, ok := concepts[st.Code]; !ok && len(boards[st.Code]) > 0 {
+				concepts[st.Code] = ladder.TopConcept(boards[st.Code], st.Reason)
+				found = true
+			}
+		}
+		// Board membership rarely changes within a day
+		if found {
+			if bytes, err := json.Marshal(concepts); err == nil {
+				_ = redis.Set(ctx, cacheKey, string(bytes), closedDayCacheTTL)
+			}
+		}
+	}
+
+	for _, st := range stocks {
+		st.Concept = concepts[st.Code]
+	}
+	return nil
+}
+
+// limitUpBoards maps the codes of the limit-up pool of date to their board count.
+// The day stored by the sentiment collector is preferred, the vendor only keeps recent sessions.
+func (s *StockServiceImpl) limitUpBoards(ctx context.Context, date string) (map[string]int, error) {
+	boards := make(map[string]int)
+	if details, err := mysql.LoadLimitUpDetails(date); err == nil && len(details) > 0 {
+		for _, d := range details {
+			boards[d.StockCode] = d.BoardCount
 		}
+		return boards, nil
 	}
 
-	return &stock.GetLimitUpPoolResponse{Stocks: thriftStocks}, nil
+	pool, err := s.GetLimitUpPool(ctx, &stock.GetLimitUpPoolRequest{Date: date})
+	if err != nil {
+		return nil, err
+	}
+	if len(pool.Stocks) == 0 {
+		return nil, fmt.Errorf("empty limit up pool")
+	}
+	for _, item := range pool.Stocks {
+		boards[item.Code] = int(item.BoardCount)
+	}
+	return boards, nil
+}
+
+// GetBrokenLimitPool implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetBrokenLimitPool(ctx context.Context, req *stock.GetBrokenLimitPoolRequest) (resp *stock.GetBrokenLimitPoolResponse, err error) {
+	date := calendar.DefaultDate(req.Date)
+	if _, err := time.Parse(calendar.DateLayout, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+	}
+	cacheKey := fmt.Sprintf("market:broken_limit:pool:%s", date)
+
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var thriftStocks []*stock.BrokenLimitStock
+		if err := json.Unmarshal([]byte(cached), &thriftStocks); err == nil {
+			return &stock.GetBrokenLimitPoolResponse{Stocks: thriftStocks, Date: date}, nil
+		}
+	}
+
+	pool, err := s.sentimentClient.GetBrokenLimitPool(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	thriftStocks := make([]*stock.BrokenLimitStock, 0, len(pool))
+	for _, item := range pool {
+		thriftStocks = append(thriftStocks, &stock.BrokenLimitStock{
+			Code:          item.Code,
+			Name:          item.Name,
+			Price:         item.Price,
+			ChangePercent: item.ChangePercent,
+			LimitUpPrice:  item.LimitUpPrice,
+			HighestChange: item.HighestChange,
+			FirstSealTime: item.FirstSealTime,
+			OpenCount:     int32(item.OpenCount),
+			Amount:        item.Amount,
+			TurnoverRate:  item.TurnoverRate,
+			Amplitude:     item.Amplitude,
+			Reason:        item.Reason,
+		})
+	}
+
+	if len(thriftStocks) > 0 {
+		if bytes, err := json.Marshal(thriftStocks); err == nil {
+			_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(date, 30*time.Second))
+		}
+	}
+
+	return &stock.GetBrokenLimitPoolResponse{Stocks: thriftStocks, Date: date}, nil
+}
+
+// GetLimitDownPool implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetLimitDownPool(ctx context.Context, req *stock.GetLimitDownPoolRequest) (resp *stock.GetLimitDownPoolResponse, err error) {
+	date := calendar.DefaultDate(req.Date)
+	if _, err := time.Parse(calendar.DateLayout, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+	}
+	cacheKey := fmt.Sprintf("market:limit_down:pool:%s", date)
+
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var thriftStocks []*stock.LimitDownStock
+		if err := json.Unmarshal([]byte(cached), &thriftStocks); err == nil {
+			return &stock.GetLimitDownPoolResponse{Stocks: thriftStocks, Date: date}, nil
+		}
+	}
+
+	pool, err := s.sentimentClient.GetLimitDownPool(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	thriftStocks := make([]*stock.LimitDownStock, 0, len(pool))
+	for _, item := range pool {
+		thriftStocks = append(thriftStocks, &stock.LimitDownStock{
+			Code:           item.Code,
+			Name:           item.Name,
+			Price:          item.Price,
+			ChangePercent:  item.ChangePercent,
+			SealAmount:     item.SealAmount,
+			LastSealTime:   item.LastSealTime,
+			BoardAmount:    item.BoardAmount,
+			ContinuousDays: int32(item.ContinuousDays),
+			OpenCount:      int32(item.OpenCount),
+			Amount:         item.Amount,
+			TurnoverRate:   item.TurnoverRate,
+			Reason:         item.Reason,
+		})
+	}
+
+	if len(thriftStocks) > 0 {
+		if bytes, err := json.Marshal(thriftStocks); err == nil {
+			_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(date, 30*time.Second))
+		}
+	}
+
+	return &stock.GetLimitDownPoolResponse{Stocks: thriftStocks, Date: date}, nil
+}
+
+// GetSentimentSnapshot implements the StockServiceImpl interface.
+// It is built from the three pools, which are cached on their own.
+func (s *StockServiceImpl) GetSentimentSnapshot(ctx context.Context, req *stock.GetSentimentSnapshotRequest) (resp *stock.GetSentimentSnapshotResponse, err error) {
+	date := calendar.DefaultDate(req.Date)
+
+	limitUp, err := s.GetLimitUpPool(ctx, &stock.GetLimitUpPoolRequest{Date: date})
+	if err != nil {
+		return nil, err
+	}
+	broken, err := s.GetBrokenLimitPool(ctx, &stock.GetBrokenLimitPoolRequest{Date: date})
+	if err != nil {
+		return nil, err
+	}
+	limitDown, err := s.GetLimitDownPool(ctx, &stock.GetLimitDownPoolRequest{Date: date})
+	if err != nil {
+		return nil, err
+	}
+
+	var highest int32
+	for _, item := range limitUp.Stocks {
+		if item.BoardCount > highest {
+			highest = item.BoardCount
+		}
+	}
+
+	return &stock.GetSentimentSnapshotResponse{
+		Snapshot: &stock.SentimentSnapshot{
+			Date:           date,
+			LimitUpCount:   int32(len(limitUp.Stocks)),
+			BrokenCount:    int32(len(broken.Stocks)),
+			LimitDownCount: int32(len(limitDown.Stocks)),
+			BrokenRate:     sentiment.BrokenRate(len(limitUp.Stocks), len(broken.Stocks)),
+			HighestBoard:   highest,
+		},
+	}, nil
+}
+
+const (
+	// defaultSentimentHistoryDays is the span of GetSentimentHistory without a start date
+	defaultSentimentHistoryDays = 60
+	// maxSentimentHistoryDays caps the span of one GetSentimentHistory request
+	maxSentimentHistoryDays = 366
+)
+
+// GetSentimentHistory implements the StockServiceImpl interface.
+// It reads the days stored by the sentiment collector.
+func (s *StockServiceImpl) GetSentimentHistory(ctx context.Context, req *stock.GetSentimentHistoryRequest) (resp *stock.GetSentimentHistoryResponse, err error) {
+	startDate, endDate, err := historyRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mysql.LoadLimitUpSummaries(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*stock.SentimentDay, 0, len(rows))
+	for _, row := range rows {
+		days = append(days, &stock.SentimentDay{
+			Date:           row.Date,
+			LimitUpCount:   int32(row.LimitUpCount),
+			BrokenCount:    int32(row.BrokenCount),
+			LimitDownCount: int32(row.LimitDownCount),
+			BrokenRate:     sentiment.BrokenRate(row.LimitUpCount, row.BrokenCount),
+			HighestBoard:   int32(row.HighestBoard),
+			SentimentScore: row.SentimentScore,
+		})
+	}
+	return &stock.GetSentimentHistoryResponse{Days: days}, nil
+}
+
+// GetMarketTemperature implements the StockServiceImpl interface.
+// It reads the temperature stored with each day by the sentiment collector.
+func (s *StockServiceImpl) GetMarketTemperature(ctx context.Context, req *stock.GetMarketTemperatureRequest) (resp *stock.GetMarketTemperatureResponse, err error) {
+	startDate, endDate, err := historyRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mysql.LoadLimitUpSummaries(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*stock.TemperatureDay, 0, len(rows))
+	for _, row := range rows {
+		var components []temperature.Component
+		if row.Components != "" {
+			if err := json.Unmarshal([]byte(row.Components), &components); err != nil {
+				log.Printf("GetMarketTemperature: invalid components of %s: %v", row.Date, err)
+			}
+		}
+		day := &stock.TemperatureDay{
+			Date:       row.Date,
+			Score:      row.SentimentScore,
+			Components: make([]*stock.TemperatureComponent, 0, len(components)),
+		}
+		for _, c := range components {
+			day.Components = append(day.Components, &stock.TemperatureComponent{Name: c.Name, Value: c.Value, Weight: c.Weight})
+		}
+		days = append(days, day)
+	}
+	return &stock.GetMarketTemperatureResponse{Days: days}, nil
+}
+
+// historyRange applies the defaults and limits of the start/end dates of the history RPCs:
+// end defaults to the latest completed trading day and start to defaultSentimentHistoryDays before it
+func historyRange(startDate, endDate string) (string, string, error) {
+	endDate = calendar.DefaultDate(endDate)
+	end, err := time.Parse(calendar.DateLayout, endDate)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid date %q, expected YYYY-MM-DD", endDate)
+	}
+
+	if startDate == "" {
+		startDate = end.AddDate(0, 0, -defaultSentimentHistoryDays).Format(calendar.DateLayout)
+	}
+	start, err := time.Parse(calendar.DateLayout, startDate)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid date %q, expected YYYY-MM-DD", startDate)
+	}
+	if start.After(end) {
+		return "", "", fmt.Errorf("start_date %s is after end_date %s", startDate, endDate)
+	}
+	if end.Sub(start) > maxSentimentHistoryDays*24*time.Hour {
+		return "", "", fmt.Errorf("date range too long, at most %d days", maxSentimentHistoryDays)
+	}
+	return startDate, endDate, nil
+}
+
+// maxBreadthHistoryDays caps GetMarketBreadthRequest.HistoryDays
+const maxBreadthHistoryDays = 20
+
+// GetMarketBreadth implements the StockServiceImpl interface.
+// The latest session is computed from the live quotes, earlier ones come from
+// the days stored by the breadth collector.
+func (s *StockServiceImpl) GetMarketBreadth(ctx context.Context, req *stock.GetMarketBreadthRequest) (resp *stock.GetMarketBreadthResponse, err error) {
+	latest := calendar.LatestTradingDay(calendar.Now()).Format(calendar.DateLayout)
+	date := req.Date
+	if date == "" {
+		date = latest
+	}
+	if _, err := time.Parse(calendar.DateLayout, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+
+	var current *stock.MarketBreadth
+	if date == latest {
+		current, err = s.liveMarketBreadth(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		row, err := mysql.LoadMarketBreadth(date)
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			return nil, fmt.Errorf("no market breadth stored for %s", date)
+		}
+		current = toThriftBreadth(date, breadth.FromModel(row))
+	}
+	resp = &stock.GetMarketBreadthResponse{Breadth: current, History: []*stock.MarketBreadth{}}
+
+	historyDays := int(req.HistoryDays)
+	if historyDays > maxBreadthHistoryDays {
+		historyDays = maxBreadthHistoryDays
+	}
+	rows, err := mysql.LoadMarketBreadthBefore(date, max(historyDays, 1))
+	if err != nil {
+		// The comparison is optional, the day itself is still useful
+		log.Printf("GetMarketBreadth: load history failed: %v", err)
+		return resp, nil
+	}
+	if len(rows) > 0 && rows[0].Amount > 0 {
+		resp.PrevAmount = rows[0].Amount
+		resp.AmountChangePercent = math.Round((current.Amount/rows[0].Amount-1)*10000) / 100
+	}
+	for i := min(historyDays, len(rows)) - 1; i >= 0; i-- {
+		resp.History = append(resp.History, toThriftBreadth(rows[i].Date, breadth.FromModel(rows[i])))
+	}
+	return resp, nil
+}
+
+// liveMarketBreadth computes the breadth of the latest session from the whole market quotes
+func (s *StockServiceImpl) liveMarketBreadth(ctx context.Context, date string) (*stock.MarketBreadth, error) {
+	cacheKey := fmt.Sprintf("market:breadth:%s", date)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var b stock.MarketBreadth
+		if err := json.Unmarshal([]byte(cached), &b); err == nil {
+			return &b, nil
+		}
+	}
+
+	quotes, err := s.eastMoneyClient.GetMarketQuotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b := toThriftBreadth(date, breadth.Compute(quotes))
+
+	// The full list takes dozens of requests, keep it a minute while trading
+	if bytes, err := json.Marshal(b); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(date, 60*time.Second))
+	}
+	return b, nil
+}
+
+func toThriftBreadth(date string, b *breadth.Breadth) *stock.MarketBreadth {
+	result := &stock.MarketBreadth{
+		Date:      date,
+		Advance:   int32(b.Advance),
+		Decline:   int32(b.Decline),
+		Flat:      int32(b.Flat),
+		Suspended: int32(b.Suspended),
+		LimitUp:   int32(b.LimitUp),
+		LimitDown: int32(b.LimitDown),
+		Amount:    b.Amount,
+		Buckets:   make([]*stock.BreadthBucket, 0, len(b.Buckets)),
+		Boards:    make([]*stock.BreadthBoard, 0, len(b.Boards)),
+	}
+	for i, count := range b.Buckets {
+		result.Buckets = append(result.Buckets, &stock.BreadthBucket{Label: breadth.BucketLabels[i], Count: int32(count)})
+	}
+	for i, board := range b.Boards {
+		result.Boards = append(result.Boards, &stock.BreadthBoard{
+			Board:     string(breadth.Boards[i]),
+			Advance:   int32(board.Advance),
+			Decline:   int32(board.Decline),
+			Flat:      int32(board.Flat),
+			LimitUp:   int32(board.LimitUp),
+			LimitDown: int32(board.LimitDown),
+			Amount:    board.Amount,
+		})
+	}
+	return result
+}
+
+const (
+	defaultDailyHistoryDays = 20
+	maxDailyHistoryDays     = 250
+)
+
+// dailyHistoryDays applies the default and the cap of the days of the northbound
+// and margin requests
+func dailyHistoryDays(days int32) int {
+	if days <= 0 {
+		return defaultDailyHistoryDays
+	}
+	if days > maxDailyHistoryDays {
+		return maxDailyHistoryDays
+	}
+	return int(days)
+}
+
+// GetNorthboundFlow implements the StockServiceImpl interface.
+// The intraday flow is best effort, the daily history is returned without it.
+func (s *StockServiceImpl) GetNorthboundFlow(ctx context.Context, req *stock.GetNorthboundFlowRequest) (resp *stock.GetNorthboundFlowResponse, err error) {
+	days := dailyHistoryDays(req.Days)
+	now := calendar.Now()
+
+	session := calendar.LatestTradingDay(now).Format(calendar.DateLayout)
+	end := session
+	if req.Date != "" {
+		if _, err := time.Parse(calendar.DateLayout, req.Date); err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+		}
+		if req.Date < session {
+			end = req.Date
+		}
+	}
+	cacheKey := fmt.Sprintf("market:northbound:%s:%d", end, days)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetNorthboundFlowResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	list, err := s.eastMoneyClient.GetNorthboundDays(ctx, days, end)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetNorthboundFlowResponse{Days: list}
+
+	// The minute flow is only published for the latest session
+	if end == session {
+		date, minutes, err := s.eastMoneyClient.GetNorthboundIntraday(ctx, now)
+		if err != nil {
+			log.Printf("GetNorthboundFlow: intraday flow unavailable: %v", err)
+		} else {
+			resp.IntradayDate = date
+			resp.Intraday = minutes
+		}
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), sessionCacheTTL(end, time.Minute))
+	}
+	return resp, nil
+}
+
+// GetNorthboundTopStocks implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetNorthboundTopStocks(ctx context.Context, req *stock.GetNorthboundTopStocksRequest) (resp *stock.GetNorthboundTopStocksResponse, err error) {
+	date := req.Date
+	if date == "" {
+		date = calendar.LatestCompletedTradingDay(calendar.Now()).Format(calendar.DateLayout)
+	}
+	if _, err := time.Parse(calendar.DateLayout, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+
+	cacheKey := fmt.Sprintf("market:northbound:top:%s", date)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var stocks []*stock.NorthboundTopStock
+		if err := json.Unmarshal([]byte(cached), &stocks); err == nil {
+			return &stock.GetNorthboundTopStocksResponse{Date: date, Stocks: stocks}, nil
+		}
+	}
+
+	stocks, err := s.eastMoneyClient.GetNorthboundTopStocks(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// The list is published after the close, don't cache the empty answer before that
+	if len(stocks) > 0 {
+		if bytes, err := json.Marshal(stocks); err == nil {
+			_ = redis.Set(ctx, cacheKey, string(bytes), closedDayCacheTTL)
+		}
+	}
+	return &stock.GetNorthboundTopStocksResponse{Date: date, Stocks: stocks}, nil
+}
+
+// GetNorthboundHolding implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetNorthboundHolding(ctx context.Context, req *stock.GetNorthboundHoldingRequest) (resp *stock.GetNorthboundHoldingResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetNorthboundHoldingResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	days := dailyHistoryDays(req.Days)
+
+	// Holdings are disclosed once a day, after the close
+	cacheKey := fmt.Sprintf("stock:northbound:%s:%d", sec.Prefixed(), days)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetNorthboundHoldingResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	name, holdings, err := s.eastMoneyClient.GetNorthboundHoldings(ctx, sec.Symbol, days)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetNorthboundHoldingResponse{
+		Code:     sec.Prefixed(),
+		Name:     name,
+		Holdings: holdings,
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetMarginTrading implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetMarginTrading(ctx context.Context, req *stock.GetMarginTradingRequest) (resp *stock.GetMarginTradingResponse, err error) {
+	if req.Code == "" {
+		return &stock.GetMarginTradingResponse{}, nil
+	}
+	sec, err := symbol.Parse(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	days := dailyHistoryDays(req.Days)
+
+	// Balances are disclosed once a day, the morning after the session
+	cacheKey := fmt.Sprintf("stock:margin:%s:%d", sec.Prefixed(), days)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var cachedResp stock.GetMarginTradingResponse
+		if err := json.Unmarshal([]byte(cached), &cachedResp); err == nil {
+			return &cachedResp, nil
+		}
+	}
+
+	name, list, err := s.eastMoneyClient.GetStockMargin(ctx, sec.Symbol, days)
+	if err != nil {
+		return nil, err
+	}
+	resp = &stock.GetMarginTradingResponse{
+		Code: sec.Prefixed(),
+		Name: name,
+		Days: list,
+	}
+
+	if bytes, err := json.Marshal(resp); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return resp, nil
+}
+
+// GetMarketMarginSummary implements the StockServiceImpl interface.
+func (s *StockServiceImpl) GetMarketMarginSummary(ctx context.Context, req *stock.GetMarketMarginSummaryRequest) (resp *stock.GetMarketMarginSummaryResponse, err error) {
+	days := dailyHistoryDays(req.Days)
+
+	cacheKey := fmt.Sprintf("market:margin:%d", days)
+	if cached, err := redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var list []*stock.MarginDay
+		if err := json.Unmarshal([]byte(cached), &list); err == nil {
+			return &stock.GetMarketMarginSummaryResponse{Days: list}, nil
+		}
+	}
+
+	list, err := s.eastMoneyClient.GetMarketMargin(ctx, days)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(list); err == nil {
+		_ = redis.Set(ctx, cacheKey, string(bytes), time.Hour)
+	}
+	return &stock.GetMarketMarginSummaryResponse{Days: list}, nil
+}
+
+// closedDayCacheTTL is how long data of a completed session is cached
+const closedDayCacheTTL = 24 * time.Hour
+
+// sessionCacheTTL returns live for the data of a session that has not closed yet,
+// and closedDayCacheTTL once date is the latest completed trading day or older
+func sessionCacheTTL(date string, live time.Duration) time.Duration {
+	if date <= calendar.LatestCompletedTradingDay(calendar.Now()).Format(calendar.DateLayout) {
+		return closedDayCacheTTL
+	}
+	return live
 }
 
 // GetSectorStocks implements the StockServiceImpl interface.
@@ -190,10 +1686,8 @@ func (s *StockServiceImpl) GetSectorStocks(ctx context.Context, req *stock.GetSe
 
 // GetDragonTigerList implements the StockServiceImpl interface.
 func (s *StockServiceImpl) GetDragonTigerList(ctx context.Context, req *stock.GetDragonTigerListRequest) (resp *stock.GetDragonTigerListResponse, err error) {
-	date := req.Date
-	if date == "" {
-		date = time.Now().Format("2006-01-02")
-	}
+	// The list is published after the close, default to the latest completed session
+	date := calendar.DefaultDate(req.Date)
 
 	items, err := s.eastMoneyClient.GetDragonTigerList(ctx, date)
 	if err != nil {
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"github.com/cloudwego/kitex/server"
 	stock "stock_assistant/backend/stock_service/kitex_gen/stock/stockservice"
 	"stock_assistant/backend/stock_service/dal/mysql"
 	"stock_assistant/backend/stock_service/dal/redis"
+	"stock_assistant/backend/stock_service/biz/security"
 )
 
 func main() {
@@ -14,8 +16,18 @@ func main() {
 	mysql.Init()
 	redis.Init()
 
+	impl := NewStockServiceImpl()
+	// Load the security master and keep it fresh in the background
+	impl.securityMaster.Start(context.Background(), security.DefaultRefreshInterval)
+	// Store each trading day's limit-up pool and sentiment after the close
+	impl.sentimentCollector.Start(context.Background())
+	// Snapshot the sector rankings through the sessions
+	impl.sectorCollector.Start(context.Background())
+	// Store the closing market breadth of each trading day
+	impl.breadthCollector.Start(context.Background())
+
 	addr, _ := net.ResolveTCPAddr("tcp", ":8888")
-	svr := stock.NewServer(NewStockServiceImpl(), server.WithServiceAddr(addr))
+	svr := stock.NewServer(impl, server.WithServiceAddr(addr))
 
 	err := svr.Run()
 
@@ -1,5 +1,15 @@
 namespace go api
 
+struct OrderBookLevel {
+    1: double price
+    2: i64 volume
+}
+
+struct OrderBook {
+    1: list<OrderBookLevel> bids
+    2: list<OrderBookLevel> asks
+}
+
 struct RealtimeResponse {
     1: string code
     2: string name
@@ -7,12 +17,460 @@ struct RealtimeResponse {
     4: double change_percent
     5: i64 volume
     6: string timestamp
+    7: string source
+    8: double open
+    9: double high
+    10: double low
+    11: double prev_close
+    12: double amount
+    13: OrderBook order_book
+    14: double wei_bi
+    15: i64 wei_cha
 }
 
 struct GetRealtimeRequest {
     1: string code (api.path="code")
 }
 
+struct GetRealtimeBatchRequest {
+    1: string codes (api.query="codes") // Comma-separated, e.g. sh600519,sz000001
+}
+
+struct GetRealtimeBatchResponse {
+    1: list<RealtimeResponse> stocks
+    2: map<string, string> errors
+}
+
+struct KLineBar {
+    1: string date
+    2: double open
+    3: double close
+    4: double high
+    5: double low
+    6: i64 volume
+    7: double amount
+    8: double change_percent
+    9: double turnover_rate
+}
+
+struct GetKLineRequest {
+    1: string code (api.path="code")
+    2: string period (api.query="period")
+    3: string adjust (api.query="adjust")
+    4: string start_date (api.query="start_date")
+    5: string end_date (api.query="end_date")
+    6: i32 limit (api.query="limit")
+}
+
+struct GetKLineResponse {
+    1: string code
+    2: string name
+    3: list<KLineBar> bars
+}
+
+struct IntradayPoint {
+    1: string time
+    2: double price
+    3: double avg_price
+    4: i64 volume
+    5: double amount
+}
+
+struct GetIntradayTrendRequest {
+    1: string code (api.path="code")
+    2: string date (api.query="date")
+}
+
+struct GetIntradayTrendResponse {
+    1: string code
+    2: string name
+    3: string date
+    4: double prev_close
+    5: list<IntradayPoint> points
+}
+
+struct FundFlowDay {
+    1: string date
+    2: double main_net
+    3: double super_large_net
+    4: double large_net
+    5: double medium_net
+    6: double small_net
+    7: double main_net_ratio
+    8: double close
+    9: double change_percent
+}
+
+struct FundFlowMinute {
+    1: string time
+    2: double main_net
+    3: double super_large_net
+    4: double large_net
+    5: double medium_net
+    6: double small_net
+}
+
+struct GetStockFundFlowRequest {
+    1: string code (api.path="code")
+    2: i32 days (api.query="days")
+}
+
+struct GetStockFundFlowResponse {
+    1: string code
+    2: string name
+    3: list<FundFlowDay> days
+    4: string intraday_date
+    5: list<FundFlowMinute> intraday
+}
+
+struct NorthboundDay {
+    1: string date
+    2: double net_buy
+    3: double sh_net_buy
+    4: double sz_net_buy
+    5: double deal_amount
+    6: double sh_deal_amount
+    7: double sz_deal_amount
+}
+
+struct NorthboundMinute {
+    1: string time
+    2: double net_buy
+    3: double sh_net_buy
+    4: double sz_net_buy
+}
+
+struct GetNorthboundFlowRequest {
+    1: i32 days (api.query="days")
+    2: string date (api.query="date")
+}
+
+struct GetNorthboundFlowResponse {
+    1: list<NorthboundDay> days
+    2: string intraday_date
+    3: list<NorthboundMinute> intraday
+}
+
+struct NorthboundTopStock {
+    1: string connect
+    2: i32 rank
+    3: string code
+    4: string name
+    5: double close
+    6: double change_percent
+    7: double net_buy
+    8: double buy_amount
+    9: double sell_amount
+    10: double deal_amount
+}
+
+struct GetNorthboundTopStocksRequest {
+    1: string date (api.query="date")
+}
+
+struct GetNorthboundTopStocksResponse {
+    1: string date
+    2: list<NorthboundTopStock> stocks
+}
+
+struct NorthboundHolding {
+    1: string date
+    2: double shares
+    3: double market_cap
+    4: double float_ratio
+    5: double shares_change
+    6: double market_cap_change
+    7: double close
+    8: double change_percent
+}
+
+struct GetNorthboundHoldingRequest {
+    1: string code (api.path="code")
+    2: i32 days (api.query="days")
+}
+
+struct GetNorthboundHoldingResponse {
+    1: string code
+    2: string name
+    3: list<NorthboundHolding> holdings
+}
+
+struct MarginDay {
+    1: string date
+    2: double financing_balance
+    3: double financing_buy
+    4: double financing_repay
+    5: double financing_net_buy
+    6: double lending_balance
+    7: double lending_volume
+    8: double total_balance
+    9: double financing_balance_change
+    10: double lending_balance_change
+    11: double total_balance_change
+    12: double close
+    13: double change_percent
+}
+
+struct GetMarginTradingRequest {
+    1: string code (api.path="code")
+    2: i32 days (api.query="days")
+}
+
+struct GetMarginTradingResponse {
+    1: string code
+    2: string name
+    3: list<MarginDay> days
+}
+
+struct GetMarketMarginSummaryRequest {
+    1: i32 days (api.query="days")
+}
+
+struct GetMarketMarginSummaryResponse {
+    1: list<MarginDay> days
+}
+
+struct IndexQuote {
+    1: string code
+    2: string name
+    3: double current_price
+    4: double change
+    5: double change_percent
+    6: double amount
+    7: i32 up_count
+    8: i32 down_count
+    9: i32 flat_count
+}
+
+struct GetIndexOverviewRequest {
+    1: string codes (api.query="codes") // Optional, comma-separated, e.g. sh000001,sz399006
+    2: string date (api.query="date")   // Optional YYYY-MM-DD, a past session without member counts
+}
+
+struct GetIndexOverviewResponse {
+    1: list<IndexQuote> indices
+}
+
+struct SecurityInfo {
+    1: string code
+    2: string symbol
+    3: string exchange
+    4: string board
+    5: string type
+    6: string name
+    7: string pinyin
+    8: string listing_date
+}
+
+struct SearchSecuritiesRequest {
+    1: string query (api.query="q")
+    2: i32 limit (api.query="limit")
+}
+
+struct SearchSecuritiesResponse {
+    1: list<SecurityInfo> securities
+}
+
+struct GetSentimentSnapshotRequest {
+    1: string date (api.query="date")
+}
+
+struct GetSentimentSnapshotResponse {
+    1: string date
+    2: i32 limit_up_count
+    3: i32 broken_count
+    4: i32 limit_down_count
+    5: double broken_rate
+    6: i32 highest_board
+}
+
+struct SentimentDay {
+    1: string date
+    2: i32 limit_up_count
+    3: i32 broken_count
+    4: i32 limit_down_count
+    5: double broken_rate
+    6: i32 highest_board
+    7: double sentiment_score
+}
+
+struct GetSentimentHistoryRequest {
+    1: string start_date (api.query="start_date")
+    2: string end_date (api.query="end_date")
+}
+
+struct GetSentimentHistoryResponse {
+    1: list<SentimentDay> days
+}
+
+struct TemperatureComponent {
+    1: string name
+    2: double value
+    3: double weight
+}
+
+struct TemperatureDay {
+    1: string date
+    2: double score
+    3: list<TemperatureComponent> components
+}
+
+struct GetMarketTemperatureRequest {
+    1: string start_date (api.query="start_date")
+    2: string end_date (api.query="end_date")
+}
+
+struct GetMarketTemperatureResponse {
+    1: list<TemperatureDay> days
+}
+
+struct SectorTimelinePoint {
+    1: string time
+    2: i32 rank
+    3: i32 total
+    4: double change_percent
+    5: double net_inflow
+    6: string top_stock_name
+}
+
+struct GetSectorTimelineRequest {
+    1: string sector_code (api.query="sector_code")
+    2: string date (api.query="date")
+}
+
+struct GetSectorTimelineResponse {
+    1: string sector_code
+    2: string name
+    3: string type
+    4: string date
+    5: list<SectorTimelinePoint> points
+}
+
+struct SectorRotationItem {
+    1: string code
+    2: string name
+    3: string type
+    4: list<i32> ranks
+    5: i32 latest_rank
+    6: i32 total
+    7: double cumulative_change
+    8: double cumulative_net_inflow
+    9: i32 top10_streak
+    10: double momentum_score
+    11: string trend
+}
+
+struct GetSectorRotationRequest {
+    1: i32 days (api.query="days")
+    2: string type (api.query="type")
+    3: i32 limit (api.query="limit")
+    4: string date (api.query="date")
+}
+
+struct GetSectorRotationResponse {
+    1: list<string> dates
+    2: list<SectorRotationItem> sectors
+}
+
+struct SectorFundFlowItem {
+    1: string code
+    2: string name
+    3: string type
+    4: double change_percent
+    5: double main_net_inflow
+    6: double main_net_ratio
+    7: string top_stock_name
+    8: list<double> daily_net_inflow
+    9: i32 inflow_streak
+}
+
+struct GetSectorFundFlowRequest {
+    1: string type (api.query="type")
+    2: string period (api.query="period")
+    3: i32 limit (api.query="limit")
+    4: i32 history_days (api.query="history_days")
+    5: i32 min_streak (api.query="min_streak")
+}
+
+struct GetSectorFundFlowResponse {
+    1: string type
+    2: string period
+    3: list<string> dates
+    4: list<SectorFundFlowItem> sectors
+}
+
+struct LadderStock {
+    1: string code
+    2: string name
+    3: double price
+    4: double change_percent
+    5: string reason // Industry board
+    6: string first_seal_time
+    7: string last_seal_time
+    8: double seal_amount
+    9: i32 open_count
+    10: double turnover_rate
+    11: string concept // Top concept board
+}
+
+struct LimitUpLadderLevel {
+    1: i32 height
+    2: list<LadderStock> stocks
+    3: i32 prev_count
+    4: i32 promoted_count
+    5: double promotion_rate
+}
+
+struct GetLimitUpLadderRequest {
+    1: string date (api.query="date")
+}
+
+struct GetLimitUpLadderResponse {
+    1: string date
+    2: string prev_date
+    3: list<LimitUpLadderLevel> levels
+}
+
+struct BreadthBucket {
+    1: string label
+    2: i32 count
+}
+
+struct BreadthBoard {
+    1: string board
+    2: i32 advance
+    3: i32 decline
+    4: i32 flat
+    5: i32 limit_up
+    6: i32 limit_down
+    7: double amount
+}
+
+struct MarketBreadth {
+    1: string date
+    2: i32 advance
+    3: i32 decline
+    4: i32 flat
+    5: i32 suspended
+    6: i32 limit_up
+    7: i32 limit_down
+    8: double amount
+    9: list<BreadthBucket> buckets
+    10: list<BreadthBoard> boards
+}
+
+struct GetMarketBreadthRequest {
+    1: string date (api.query="date")
+    2: i32 history_days (api.query="history_days")
+}
+
+struct GetMarketBreadthResponse {
+    1: MarketBreadth breadth
+    2: double prev_amount
+    3: double amount_change_percent
+    4: list<MarketBreadth> history
+}
+
 struct PredictionRequest {
     1: string code (api.path="code")
     2: i32 days (api.body="days")
@@ -56,6 +514,232 @@ struct GetFinancialReportResponse {
     1: list<FinancialData> reports
 }
 
+struct IncomeStatement {
+    1: double total_revenue
+    2: double revenue
+    3: double operating_cost
+    4: double selling_expense
+    5: double admin_expense
+    6: double rd_expense
+    7: double finance_expense
+    8: double operating_profit
+    9: double total_profit
+    10: double income_tax
+    11: double net_profit
+    12: double parent_net_profit
+    13: double deducted_net_profit
+    14: double eps
+}
+
+struct BalanceSheet {
+    1: double total_assets
+    2: double current_assets
+    3: double cash
+    4: double receivables
+    5: double inventory
+    6: double fixed_assets
+    7: double goodwill
+    8: double total_liabilities
+    9: double current_liabilities
+    10: double short_term_loans
+    11: double long_term_loans
+    12: double total_equity
+    13: double parent_equity
+}
+
+struct CashFlowStatement {
+    1: double operating_cash_flow
+    2: double investing_cash_flow
+    3: double financing_cash_flow
+    4: double capex
+    5: double net_cash_change
+    6: double ending_cash
+}
+
+struct FinancialRatios {
+    1: double roe
+    2: double gross_margin
+    3: double net_margin
+    4: double debt_ratio
+    5: double ocf_to_net_profit
+}
+
+struct FinancialPeriod {
+    1: string report_date
+    2: string report_type
+    3: IncomeStatement income
+    4: BalanceSheet balance
+    5: CashFlowStatement cash_flow
+    6: FinancialRatios ratios
+}
+
+struct GetFinancialStatementsRequest {
+    1: string code (api.path="code")
+    2: string view (api.query="view")
+    3: i32 periods (api.query="periods")
+}
+
+struct GetFinancialStatementsResponse {
+    1: string code
+    2: string name
+    3: string view
+    4: list<FinancialPeriod> periods
+}
+
+struct ValuationDay {
+    1: string date
+    2: double close
+    3: double pe_ttm
+    4: double pb
+    5: double ps_ttm
+    6: double market_cap
+}
+
+struct ValuationBand {
+    1: double current
+    2: double percentile
+    3: double min
+    4: double median
+    5: double max
+    6: double industry_median
+}
+
+struct GetValuationRequest {
+    1: string code (api.path="code")
+    2: i32 years (api.query="years")
+}
+
+struct GetValuationResponse {
+    1: string code
+    2: string name
+    3: string industry
+    4: i32 years
+    5: list<ValuationDay> days
+    6: ValuationBand pe_ttm
+    7: ValuationBand pb
+    8: ValuationBand ps_ttm
+}
+
+struct EarningsDisclosure {
+    1: string code
+    2: string name
+    3: string report_date
+    4: string report_type
+    5: string scheduled_date
+    6: string first_scheduled_date
+    7: i32 reschedules
+    8: string actual_date
+}
+
+struct EarningsPreview {
+    1: string code
+    2: string name
+    3: string report_date
+    4: string notice_date
+    5: string indicator
+    6: string type
+    7: double lower
+    8: double upper
+    9: double change_lower
+    10: double change_upper
+    11: double last_year
+    12: string reason
+}
+
+struct EarningsExpress {
+    1: string code
+    2: string name
+    3: string report_date
+    4: string notice_date
+    5: double revenue
+    6: double revenue_yoy
+    7: double net_profit
+    8: double net_profit_yoy
+    9: double eps
+    10: double roe
+}
+
+struct GetEarningsCalendarRequest {
+    1: string start (api.query="start")
+    2: string end (api.query="end")
+}
+
+struct GetEarningsCalendarResponse {
+    1: string start
+    2: string end
+    3: list<EarningsDisclosure> disclosures
+    4: list<EarningsPreview> previews
+    5: list<EarningsExpress> expresses
+}
+
+struct GetStockEarningsRequest {
+    1: string code (api.path="code")
+    2: string start (api.query="start")
+    3: string end (api.query="end")
+}
+
+struct ShareUnlock {
+    1: string code
+    2: string name
+    3: string date
+    4: double shares
+    5: double market_cap
+    6: double float_ratio
+    7: string holder_type
+}
+
+struct DividendPlan {
+    1: string code
+    2: string name
+    3: string report_date
+    4: string notice_date
+    5: string record_date
+    6: string ex_date
+    7: double cash_per_ten
+    8: double bonus_per_ten
+    9: double transfer_per_ten
+    10: string progress
+    11: string plan
+}
+
+struct GetShareUnlocksRequest {
+    1: string code (api.path="code")
+    2: string start (api.query="start")
+    3: string end (api.query="end")
+}
+
+struct GetShareUnlocksResponse {
+    1: string code
+    2: string start
+    3: string end
+    4: list<ShareUnlock> unlocks
+}
+
+struct GetDividendsRequest {
+    1: string code (api.path="code")
+    2: string start (api.query="start")
+    3: string end (api.query="end")
+}
+
+struct GetDividendsResponse {
+    1: string code
+    2: string start
+    3: string end
+    4: list<DividendPlan> dividends
+}
+
+struct GetCorporateActionCalendarRequest {
+    1: string start (api.query="start")
+    2: string end (api.query="end")
+}
+
+struct GetCorporateActionCalendarResponse {
+    1: string start
+    2: string end
+    3: list<ShareUnlock> unlocks
+    4: list<DividendPlan> dividends
+}
+
 struct MarketReviewRequest {
     1: string date (api.body="date") // Optional: YYYY-MM-DD
     2: list<string> focus_sectors (api.body="focus_sectors") // Optional
@@ -83,11 +767,37 @@ struct MarketAnalysisResponse {
 
 service StockAPI {
     RealtimeResponse GetRealtime(1: GetRealtimeRequest req) (api.get="/api/stocks/:code/realtime")
+    GetRealtimeBatchResponse GetRealtimeBatch(1: GetRealtimeBatchRequest req) (api.get="/api/stocks/realtime")
+    GetKLineResponse GetKLine(1: GetKLineRequest req) (api.get="/api/stocks/:code/kline")
+    GetIntradayTrendResponse GetIntradayTrend(1: GetIntradayTrendRequest req) (api.get="/api/stocks/:code/intraday")
+    GetStockFundFlowResponse GetStockFundFlow(1: GetStockFundFlowRequest req) (api.get="/api/stocks/:code/fundflow")
+    GetNorthboundHoldingResponse GetNorthboundHolding(1: GetNorthboundHoldingRequest req) (api.get="/api/stocks/:code/northbound")
+    GetMarginTradingResponse GetMarginTrading(1: GetMarginTradingRequest req) (api.get="/api/stocks/:code/margin")
+    SearchSecuritiesResponse SearchSecurities(1: SearchSecuritiesRequest req) (api.get="/api/stocks/search")
     PredictionResponse GetPrediction(1: PredictionRequest req) (api.post="/api/prediction/:code")
     ImageRecognitionResponse RecognizeStockImage(1: ImageRecognitionRequest req) (api.post="/api/image/recognize")
     GetFinancialReportResponse GetFinancialReport(1: GetFinancialReportRequest req) (api.get="/api/stocks/:code/financial")
+    GetFinancialStatementsResponse GetFinancialStatements(1: GetFinancialStatementsRequest req) (api.get="/api/stocks/:code/financial/statements")
+    GetValuationResponse GetValuation(1: GetValuationRequest req) (api.get="/api/stocks/:code/valuation")
+    GetEarningsCalendarResponse GetStockEarnings(1: GetStockEarningsRequest req) (api.get="/api/stocks/:code/earnings")
+    GetShareUnlocksResponse GetShareUnlocks(1: GetShareUnlocksRequest req) (api.get="/api/stocks/:code/unlocks")
+    GetDividendsResponse GetDividends(1: GetDividendsRequest req) (api.get="/api/stocks/:code/dividends")
     MarketReviewResponse MarketReview(1: MarketReviewRequest req) (api.post="/api/market/review")
     MarketAnalysisResponse AnalyzeMarket(1: MarketAnalysisRequest req) (api.post="/api/market/analysis")
+    GetIndexOverviewResponse GetIndexOverview(1: GetIndexOverviewRequest req) (api.get="/api/market/indices")
+    GetSentimentSnapshotResponse GetSentimentSnapshot(1: GetSentimentSnapshotRequest req) (api.get="/api/market/sentiment")
+    GetSentimentHistoryResponse GetSentimentHistory(1: GetSentimentHistoryRequest req) (api.get="/api/market/sentiment/history")
+    GetMarketTemperatureResponse GetMarketTemperature(1: GetMarketTemperatureRequest req) (api.get="/api/market/temperature")
+    GetNorthboundFlowResponse GetNorthboundFlow(1: GetNorthboundFlowRequest req) (api.get="/api/market/northbound")
+    GetNorthboundTopStocksResponse GetNorthboundTopStocks(1: GetNorthboundTopStocksRequest req) (api.get="/api/market/northbound/top")
+    GetMarketMarginSummaryResponse GetMarketMarginSummary(1: GetMarketMarginSummaryRequest req) (api.get="/api/market/margin")
+    GetEarningsCalendarResponse GetEarningsCalendar(1: GetEarningsCalendarRequest req) (api.get="/api/market/earnings")
+    GetCorporateActionCalendarResponse GetCorporateActionCalendar(1: GetCorporateActionCalendarRequest req) (api.get="/api/market/corporate_actions")
+    GetSectorTimelineResponse GetSectorTimeline(1: GetSectorTimelineRequest req) (api.get="/api/market/sector/timeline")
+    GetSectorRotationResponse GetSectorRotation(1: GetSectorRotationRequest req) (api.get="/api/market/sector/rotation")
+    GetSectorFundFlowResponse GetSectorFundFlow(1: GetSectorFundFlowRequest req) (api.get="/api/market/sector/fundflow")
+    GetLimitUpLadderResponse GetLimitUpLadder(1: GetLimitUpLadderRequest req) (api.get="/api/market/limit_up/ladder")
+    GetMarketBreadthResponse GetMarketBreadth(1: GetMarketBreadthRequest req) (api.get="/api/market/breadth")
     
     // Phase 2: Sector Details & Dragon Tiger List
     GetSectorStocksResponse GetSectorStocks(1: GetSectorStocksRequest req) (api.get="/api/stock/sector/stocks")
@@ -1,5 +1,15 @@
 namespace go stock
 
+struct OrderBookLevel {
+    1: double price
+    2: i64 volume // Shares
+}
+
+struct OrderBook {
+    1: list<OrderBookLevel> bids // Buy1..Buy5, best price first
+    2: list<OrderBookLevel> asks // Sell1..Sell5, best price first
+}
+
 struct StockInfo {
     1: string code
     2: string name
@@ -7,6 +17,15 @@ struct StockInfo {
     4: double change_percent
     5: i64 volume
     6: string timestamp
+    7: string source // Quote feed that answered: "sina", "eastmoney" or "tencent"
+    8: double open
+    9: double high
+    10: double low
+    11: double prev_close
+    12: double amount          // Turnover in CNY
+    13: OrderBook order_book   // Five-level bid/ask
+    14: double wei_bi          // 委比 (%): (bid vol - ask vol) / (bid vol + ask vol) * 100
+    15: i64 wei_cha            // 委差: bid vol - ask vol, in shares
 }
 
 struct GetRealtimeRequest {
@@ -17,6 +36,15 @@ struct GetRealtimeResponse {
     1: StockInfo stock
 }
 
+struct GetRealtimeBatchRequest {
+    1: list<string> codes
+}
+
+struct GetRealtimeBatchResponse {
+    1: list<StockInfo> stocks          // In request order, failed codes are skipped
+    2: map<string, string> errors      // Requested code -> reason it could not be fetched
+}
+
 struct FinancialData {
     1: string report_date
     2: double total_revenue // 营业总收入
@@ -34,6 +62,503 @@ struct GetFinancialReportResponse {
     1: list<FinancialData> reports
 }
 
+// --- Financial Statements ---
+// Amounts in CNY. Income and cash flow items cover the period of the view, balance
+// sheet items are taken at the report date.
+
+struct IncomeStatement {
+    1: double total_revenue          // 营业总收入
+    2: double revenue                // 营业收入
+    3: double operating_cost         // 营业成本
+    4: double selling_expense        // 销售费用
+    5: double admin_expense          // 管理费用
+    6: double rd_expense             // 研发费用
+    7: double finance_expense        // 财务费用
+    8: double operating_profit       // 营业利润
+    9: double total_profit           // 利润总额
+    10: double income_tax            // 所得税
+    11: double net_profit            // 净利润
+    12: double parent_net_profit     // 归母净利润
+    13: double deducted_net_profit   // 扣非归母净利润
+    14: double eps                   // 基本每股收益
+}
+
+struct BalanceSheet {
+    1: double total_assets
+    2: double current_assets
+    3: double cash                   // 货币资金
+    4: double receivables            // 应收账款
+    5: double inventory
+    6: double fixed_assets
+    7: double goodwill
+    8: double total_liabilities
+    9: double current_liabilities
+    10: double short_term_loans
+    11: double long_term_loans
+    12: double total_equity
+    13: double parent_equity         // 归母股东权益
+}
+
+struct CashFlowStatement {
+    1: double operating_cash_flow    // 经营活动现金流量净额
+    2: double investing_cash_flow
+    3: double financing_cash_flow
+    4: double capex                  // 购建固定资产、无形资产和其他长期资产支付的现金
+    5: double net_cash_change
+    6: double ending_cash
+}
+
+// FinancialRatios are derived from the statements of the same period, in %
+// except ocf_to_net_profit
+struct FinancialRatios {
+    1: double roe                    // 归母净利润 / 归母股东权益, over the period of the view
+    2: double gross_margin           // (营业收入 - 营业成本) / 营业收入
+    3: double net_margin             // 归母净利润 / 营业总收入
+    4: double debt_ratio             // 总负债 / 总资产
+    5: double ocf_to_net_profit      // 经营现金流 / 归母净利润
+}
+
+struct FinancialPeriod {
+    1: string report_date            // YYYY-MM-DD, end of the period
+    2: string report_type            // "Q1", "H1", "Q3" or "FY" of the report the period ends with
+    3: IncomeStatement income
+    4: BalanceSheet balance
+    5: CashFlowStatement cash_flow
+    6: FinancialRatios ratios
+}
+
+struct GetFinancialStatementsRequest {
+    1: string code
+    2: string view   // "report" (year to date, as published), "quarter" (single quarter), "annual" or "ttm"; default "report"
+    3: i32 periods   // default 8, at most 40
+}
+
+struct GetFinancialStatementsResponse {
+    1: string code
+    2: string name
+    3: string view
+    4: list<FinancialPeriod> periods // Newest first
+}
+
+// --- Valuation (估值) ---
+
+// ValuationDay is the valuation of a stock at the close of one session, a ratio of a
+// loss making company is negative
+struct ValuationDay {
+    1: string date
+    2: double close
+    3: double pe_ttm
+    4: double pb        // MRQ
+    5: double ps_ttm
+    6: double market_cap // CNY
+}
+
+// ValuationBand places the latest value of one ratio within the look-back window and the industry
+struct ValuationBand {
+    1: double current
+    2: double percentile      // Share of the window's sessions valued lower, 0-100
+    3: double min
+    4: double median
+    5: double max
+    6: double industry_median // Across the industry's stocks on the latest session
+}
+
+struct GetValuationRequest {
+    1: string code
+    2: i32 years // Look-back window, default 5, at most 10
+}
+
+struct GetValuationResponse {
+    1: string code
+    2: string name
+    3: string industry
+    4: i32 years
+    5: list<ValuationDay> days // Oldest first
+    6: ValuationBand pe_ttm    // Left empty when the latest PE is negative
+    7: ValuationBand pb
+    8: ValuationBand ps_ttm
+}
+
+// --- Earnings Calendar (业绩披露) ---
+
+// EarningsDisclosure is the scheduled disclosure (预约披露) of one periodic report
+struct EarningsDisclosure {
+    1: string code
+    2: string name
+    3: string report_date          // Period end, YYYY-MM-DD
+    4: string report_type          // "Q1", "H1", "Q3" or "FY"
+    5: string scheduled_date       // Latest scheduled date, after any reschedule
+    6: string first_scheduled_date
+    7: i32 reschedules             // Times the date was moved
+    8: string actual_date          // Empty until published
+}
+
+// EarningsPreview is a performance preview (业绩预告), amounts in CNY
+struct EarningsPreview {
+    1: string code
+    2: string name
+    3: string report_date
+    4: string notice_date
+    5: string indicator      // The forecast item, e.g. 归属于上市公司股东的净利润
+    6: string type           // 预增, 预减, 扭亏, 首亏, 续亏, 续盈, 略增, 略减...
+    7: double lower          // Forecast range
+    8: double upper
+    9: double change_lower   // Year-over-year change range, %
+    10: double change_upper
+    11: double last_year     // Same period of last year
+    12: string reason
+}
+
+// EarningsExpress is an express report (业绩快报), amounts in CNY
+struct EarningsExpress {
+    1: string code
+    2: string name
+    3: string report_date
+    4: string notice_date
+    5: double revenue
+    6: double revenue_yoy    // %
+    7: double net_profit     // Attributable to the parent
+    8: double net_profit_yoy // %
+    9: double eps
+    10: double roe          // Weighted, %
+}
+
+// GetEarningsCalendarRequest asks for the whole market over a window, or for the history
+// of one stock when code is set
+struct GetEarningsCalendarRequest {
+    1: string start // YYYY-MM-DD; market default today, stock default 3 years ago
+    2: string end   // YYYY-MM-DD; market default start + 14 days (at most 62 days), stock default 1 year ahead
+    3: string code  // Optional
+}
+
+struct GetEarningsCalendarResponse {
+    1: string start
+    2: string end
+    3: list<EarningsDisclosure> disclosures // By scheduled date
+    4: list<EarningsPreview> previews       // By notice date
+    5: list<EarningsExpress> expresses      // By notice date
+}
+
+// --- Corporate Actions (限售解禁 / 分红送配) ---
+
+// ShareUnlock is one lift of restricted shares (限售解禁)
+struct ShareUnlock {
+    1: string code
+    2: string name
+    3: string date          // Unlock date, YYYY-MM-DD
+    4: double shares        // Shares unlocked
+    5: double market_cap    // Value of the unlocked shares, CNY; estimated before the date
+    6: double float_ratio   // % of the float before the unlock
+    7: string holder_type   // e.g. 首发原股东限售股份, 定向增发机构配售股份, 股权激励限售股份
+}
+
+// DividendPlan is one dividend or bonus share plan (分红送配), per 10 shares
+struct DividendPlan {
+    1: string code
+    2: string name
+    3: string report_date      // Period the plan pays out for
+    4: string notice_date      // Plan announced
+    5: string record_date      // 股权登记日, empty until fixed
+    6: string ex_date          // 除权除息日, empty until fixed
+    7: double cash_per_ten     // Cash before tax, CNY
+    8: double bonus_per_ten    // Bonus shares (送股)
+    9: double transfer_per_ten // Shares converted from reserves (转增)
+    10: string progress        // e.g. 董事会预案, 股东大会通过, 实施分配
+    11: string plan            // As announced, e.g. 10派30.00元(含税)
+}
+
+// Windows follow GetEarningsCalendarRequest: one stock defaults to 3 years back and 1 year
+// ahead, the market to start + 14 days (at most 62 days)
+struct GetShareUnlocksRequest {
+    1: string code
+    2: string start
+    3: string end
+}
+
+struct GetShareUnlocksResponse {
+    1: string code
+    2: string start
+    3: string end
+    4: list<ShareUnlock> unlocks // By date
+}
+
+struct GetDividendsRequest {
+    1: string code
+    2: string start
+    3: string end
+}
+
+struct GetDividendsResponse {
+    1: string code
+    2: string start
+    3: string end
+    4: list<DividendPlan> dividends // By ex-date, plans without one by notice date
+}
+
+struct GetCorporateActionCalendarRequest {
+    1: string start
+    2: string end
+}
+
+// GetCorporateActionCalendarResponse is the whole market's unlocks and ex-dates within the window
+struct GetCorporateActionCalendarResponse {
+    1: string start
+    2: string end
+    3: list<ShareUnlock> unlocks
+    4: list<DividendPlan> dividends
+}
+
+// --- K-line ---
+
+struct KLineBar {
+    1: string date            // YYYY-MM-DD, or YYYY-MM-DD HH:MM for minute bars
+    2: double open
+    3: double close
+    4: double high
+    5: double low
+    6: i64 volume             // Shares
+    7: double amount          // CNY
+    8: double change_percent
+    9: double turnover_rate   // 换手率 (%)
+}
+
+struct GetKLineRequest {
+    1: string code
+    2: string period      // "1m", "5m", "15m", "30m", "60m", "day", "week", "month"; default "day"
+    3: string adjust      // "none", "forward" (前复权) or "backward" (后复权); default "forward"
+    4: string start_date  // Optional, YYYY-MM-DD
+    5: string end_date    // Optional, YYYY-MM-DD, default today
+    6: i32 limit          // Max bars counted back from end_date, default 120 when start_date is empty
+}
+
+struct GetKLineResponse {
+    1: string code
+    2: string name
+    3: list<KLineBar> bars // Oldest first
+}
+
+// --- Intraday (分时) ---
+
+struct IntradayPoint {
+    1: string time       // HH:MM
+    2: double price
+    3: double avg_price  // 均价
+    4: i64 volume        // Shares traded in this minute
+    5: double amount     // CNY traded in this minute
+}
+
+struct GetIntradayTrendRequest {
+    1: string code
+    2: string date // Optional, YYYY-MM-DD within the last 5 sessions, default latest session
+}
+
+struct GetIntradayTrendResponse {
+    1: string code
+    2: string name
+    3: string date
+    4: double prev_close
+    5: list<IntradayPoint> points
+}
+
+// --- Fund Flow (资金流向) ---
+
+// FundFlowDay is the net inflow (CNY) by order size of one session, main = super large + large
+struct FundFlowDay {
+    1: string date
+    2: double main_net
+    3: double super_large_net // 超大单
+    4: double large_net       // 大单
+    5: double medium_net      // 中单
+    6: double small_net       // 小单
+    7: double main_net_ratio  // Main net inflow as % of turnover
+    8: double close
+    9: double change_percent
+}
+
+// FundFlowMinute is the net inflow (CNY) accumulated from the open up to time
+struct FundFlowMinute {
+    1: string time // HH:MM
+    2: double main_net
+    3: double super_large_net
+    4: double large_net
+    5: double medium_net
+    6: double small_net
+}
+
+struct GetStockFundFlowRequest {
+    1: string code
+    2: i32 days // Sessions counted back from the latest, default 20, max 120
+}
+
+struct GetStockFundFlowResponse {
+    1: string code
+    2: string name
+    3: list<FundFlowDay> days        // Oldest first
+    4: string intraday_date          // Session of intraday
+    5: list<FundFlowMinute> intraday // Cumulative minute flow of the latest session
+}
+
+// --- Northbound (沪深港通北向资金) ---
+
+// NorthboundDay is the northbound trading of one session, amounts in CNY
+struct NorthboundDay {
+    1: string date
+    2: double net_buy         // 沪股通 + 深股通
+    3: double sh_net_buy      // 沪股通
+    4: double sz_net_buy      // 深股通
+    5: double deal_amount     // Buy + sell turnover
+    6: double sh_deal_amount
+    7: double sz_deal_amount
+}
+
+// NorthboundMinute is the net buy (CNY) accumulated from the open up to time
+struct NorthboundMinute {
+    1: string time // HH:MM
+    2: double net_buy
+    3: double sh_net_buy
+    4: double sz_net_buy
+}
+
+struct GetNorthboundFlowRequest {
+    1: i32 days    // Sessions counted back from date, default 20, max 250
+    2: string date // Optional YYYY-MM-DD, the last session; intraday is only filled for the latest one
+}
+
+struct GetNorthboundFlowResponse {
+    1: list<NorthboundDay> days        // Oldest first
+    2: string intraday_date            // Session of intraday
+    3: list<NorthboundMinute> intraday // Cumulative minute net buy of the latest session
+}
+
+// NorthboundTopStock is one of the top 10 traded stocks of a connect
+struct NorthboundTopStock {
+    1: string connect // "sh" (沪股通) or "sz" (深股通)
+    2: i32 rank       // 1-10 within the connect
+    3: string code
+    4: string name
+    5: double close
+    6: double change_percent
+    7: double net_buy     // CNY
+    8: double buy_amount
+    9: double sell_amount
+    10: double deal_amount
+}
+
+struct GetNorthboundTopStocksRequest {
+    1: string date // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetNorthboundTopStocksResponse {
+    1: string date
+    2: list<NorthboundTopStock> stocks // 沪股通 first, by rank
+}
+
+// NorthboundHolding is the northbound holding of one stock at the close of date
+struct NorthboundHolding {
+    1: string date
+    2: double shares
+    3: double market_cap        // CNY
+    4: double float_ratio       // % of the float shares
+    5: double shares_change     // Against the previous day
+    6: double market_cap_change // Against the previous day, includes the price move
+    7: double close
+    8: double change_percent
+}
+
+struct GetNorthboundHoldingRequest {
+    1: string code
+    2: i32 days // Sessions counted back from the latest, default 20, max 250
+}
+
+struct GetNorthboundHoldingResponse {
+    1: string code
+    2: string name
+    3: list<NorthboundHolding> holdings // Oldest first
+}
+
+// --- Margin Trading (融资融券) ---
+
+// MarginDay is the margin position at the close of one session, amounts in CNY
+struct MarginDay {
+    1: string date
+    2: double financing_balance         // 融资余额
+    3: double financing_buy             // 融资买入额
+    4: double financing_repay           // 融资偿还额
+    5: double financing_net_buy         // 融资净买入额
+    6: double lending_balance           // 融券余额
+    7: double lending_volume            // 融券余量 (shares), stocks only
+    8: double total_balance             // 融资融券余额
+    9: double financing_balance_change  // Against the previous session
+    10: double lending_balance_change
+    11: double total_balance_change
+    12: double close                    // Stocks only
+    13: double change_percent           // Stocks only
+}
+
+struct GetMarginTradingRequest {
+    1: string code
+    2: i32 days // Sessions counted back from the latest, default 20, max 250
+}
+
+struct GetMarginTradingResponse {
+    1: string code
+    2: string name
+    3: list<MarginDay> days // Oldest first
+}
+
+struct GetMarketMarginSummaryRequest {
+    1: i32 days // Sessions counted back from the latest, default 20, max 250
+}
+
+struct GetMarketMarginSummaryResponse {
+    1: list<MarginDay> days // Whole market, oldest first
+}
+
+// --- Index Overview ---
+
+struct IndexQuote {
+    1: string code           // Prefixed, e.g. sh000001
+    2: string name
+    3: double current_price
+    4: double change
+    5: double change_percent
+    6: double amount         // Turnover in CNY
+    7: i32 up_count          // Members closing up
+    8: i32 down_count        // Members closing down
+    9: i32 flat_count        // Members unchanged
+}
+
+struct GetIndexOverviewRequest {
+    1: list<string> codes // Optional, prefixed index codes; default 上证指数, 深证成指, 创业板指, 科创50, 北证50
+    2: string date        // Optional YYYY-MM-DD; a past session comes from the daily K-line, without member counts
+}
+
+struct GetIndexOverviewResponse {
+    1: list<IndexQuote> indices
+}
+
+// --- Security Master ---
+
+struct SecurityInfo {
+    1: string code          // Prefixed, e.g. sh600519
+    2: string symbol        // 600519
+    3: string exchange      // "SH", "SZ" or "BJ"
+    4: string board         // "main", "star", "chinext", "bse", "etf" or "index"
+    5: string type          // "stock", "etf" or "index"
+    6: string name
+    7: string pinyin        // Initials, e.g. "gzmt"
+    8: string listing_date  // YYYY-MM-DD
+}
+
+struct SearchSecuritiesRequest {
+    1: string query // Code, Chinese name or pinyin initials, prefix and fuzzy matched
+    2: i32 limit    // default 10, max 50
+}
+
+struct SearchSecuritiesResponse {
+    1: list<SecurityInfo> securities // Best match first
+}
+
 // --- New Structures for Sector & Sentiment ---
 
 struct SectorInfo {
@@ -55,31 +580,311 @@ struct GetMarketSectorsResponse {
     1: list<SectorInfo> sectors
 }
 
+// SectorTimelinePoint is a sector in one intraday ranking snapshot
+struct SectorTimelinePoint {
+    1: string time // HH:MM:SS
+    2: i32 rank    // 1-based, by change percent within the sector type
+    3: i32 total   // Number of sectors ranked
+    4: double change_percent
+    5: double net_inflow
+    6: string top_stock_name
+}
+
+struct GetSectorTimelineRequest {
+    1: string sector_code
+    2: string date // Optional, YYYY-MM-DD, default the latest session, including a running one
+}
+
+struct GetSectorTimelineResponse {
+    1: string sector_code
+    2: string name
+    3: string type // "concept" or "industry"
+    4: string date
+    5: list<SectorTimelinePoint> points // Earliest first
+}
+
+// SectorRotationItem is how one sector ranked over the last trading days
+struct SectorRotationItem {
+    1: string code
+    2: string name
+    3: string type
+    4: list<i32> ranks                // One per date of the response, 0 when not ranked
+    5: i32 latest_rank
+    6: i32 total                      // Sectors ranked on the latest day
+    7: double cumulative_change       // Compounded change over the window (%)
+    8: double cumulative_net_inflow   // CNY
+    9: i32 top10_streak               // Consecutive days in the top 10 up to the latest day
+    10: double momentum_score         // 0-100, see sector.Compute
+    11: string trend                  // "rising", "fading" or "steady"
+}
+
+struct GetSectorRotationRequest {
+    1: i32 days    // Trading days, default 5, at most 20
+    2: string type // "concept" or "industry", default "concept"
+    3: i32 limit   // default 20
+    4: string date // Optional YYYY-MM-DD, the last session of the window, default the latest
+}
+
+struct GetSectorRotationResponse {
+    1: list<string> dates                  // The window, oldest first
+    2: list<SectorRotationItem> sectors    // Strongest momentum first
+}
+
+// SectorFundFlowItem is the main-force money flow (主力资金) of one sector
+struct SectorFundFlowItem {
+    1: string code
+    2: string name
+    3: string type
+    4: double change_percent          // Over the period (%)
+    5: double main_net_inflow         // Over the period (CNY)
+    6: double main_net_ratio          // Main net inflow as % of turnover over the period
+    7: string top_stock_name          // Largest main net inflow of the period
+    8: list<double> daily_net_inflow  // One per date of the response (CNY), 0 when not stored
+    9: i32 inflow_streak              // Consecutive days of net inflow up to the latest date
+}
+
+struct GetSectorFundFlowRequest {
+    1: string type         // "concept" or "industry", default "concept"
+    2: string period       // "today", "5d" or "10d", default "today"
+    3: i32 limit           // default 20
+    4: i32 history_days    // Trading days of daily_net_inflow, default 10, at most 20
+    5: i32 min_streak      // Optional, only sectors with at least this inflow streak
+}
+
+struct GetSectorFundFlowResponse {
+    1: string type
+    2: string period
+    3: list<string> dates               // Dates of daily_net_inflow, oldest first
+    4: list<SectorFundFlowItem> sectors // Largest main net inflow over the period first
+}
+
 struct LimitUpStock {
     1: string code
     2: string name
     3: double price
     4: double change_percent
     5: string limit_up_type // e.g., "首板", "2连板"
-    6: string reason
-    7: bool is_broken
+    6: string reason        // Industry board (行业), e.g. "半导体"
+    7: bool is_broken         // The seal opened at least once during the day
+    8: string first_seal_time // HH:MM:SS, 首次封板时间
+    9: string last_seal_time  // HH:MM:SS, 最后封板时间
+    10: double seal_amount    // 封单额 (CNY)
+    11: i32 open_count        // 炸板次数
+    12: double turnover_rate  // 换手率 (%)
+    13: i32 board_count       // 连板数, 1 for 首板
+    14: string concept        // Top concept board (核心题材), e.g. "华为概念"; only filled by GetLimitUpLadder
 }
 
 struct GetLimitUpPoolRequest {
-    1: string date // Optional, YYYY-MM-DD
+    1: string date // Optional, YYYY-MM-DD within roughly the last month, default latest completed trading day
 }
 
 struct GetLimitUpPoolResponse {
     1: list<LimitUpStock> stocks
+    2: string date // The trading day the pool belongs to
+}
+
+// LimitUpLadderLevel is one height of the 连板天梯
+struct LimitUpLadderLevel {
+    1: i32 height                // Board count, 1 for 首板
+    2: list<LimitUpStock> stocks // Earliest first seal first, reason is the stock's sector
+    3: i32 prev_count            // Stocks at height - 1 on the previous trading day
+    4: i32 promoted_count        // How many of them reached height
+    5: double promotion_rate     // 晋级率 (%)
+}
+
+struct GetLimitUpLadderRequest {
+    1: string date // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetLimitUpLadderResponse {
+    1: string date
+    2: string prev_date                 // The day promotions are measured from, empty when unavailable
+    3: list<LimitUpLadderLevel> levels  // Highest first
+}
+
+struct BrokenLimitStock {
+    1: string code
+    2: string name
+    3: double price
+    4: double change_percent
+    5: double limit_up_price   // 涨停价
+    6: double highest_change   // Change at the limit price, the day's high (%)
+    7: string first_seal_time  // HH:MM:SS; the feed has no time for the break itself
+    8: i32 open_count          // 炸板次数
+    9: double amount           // CNY
+    10: double turnover_rate   // 换手率 (%)
+    11: double amplitude       // 振幅 (%)
+    12: string reason          // Industry
+}
+
+struct GetBrokenLimitPoolRequest {
+    1: string date // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetBrokenLimitPoolResponse {
+    1: list<BrokenLimitStock> stocks
+    2: string date
+}
+
+struct LimitDownStock {
+    1: string code
+    2: string name
+    3: double price
+    4: double change_percent
+    5: double seal_amount      // 封单额 (CNY)
+    6: string last_seal_time   // HH:MM:SS
+    7: double board_amount     // 板上成交额 (CNY)
+    8: i32 continuous_days     // 连续跌停天数
+    9: i32 open_count          // 开板次数
+    10: double amount          // CNY
+    11: double turnover_rate   // 换手率 (%)
+    12: string reason          // Industry
+}
+
+struct GetLimitDownPoolRequest {
+    1: string date // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetLimitDownPoolResponse {
+    1: list<LimitDownStock> stocks
+    2: string date
+}
+
+struct SentimentSnapshot {
+    1: string date
+    2: i32 limit_up_count    // Closed at limit-up
+    3: i32 broken_count      // Touched limit-up but did not close there
+    4: i32 limit_down_count
+    5: double broken_rate    // 炸板率 (%): broken / (limit_up + broken) * 100
+    6: i32 highest_board     // 最高连板数
+}
+
+struct BreadthBucket {
+    1: string label // "limit_up", ">=7", "5~7", "3~5", "0~3", "flat", "-3~0", "-5~-3", "-7~-5", "<=-7", "limit_down"
+    2: i32 count
+}
+
+struct BreadthBoard {
+    1: string board // "main", "chinext", "star" or "bse"
+    2: i32 advance
+    3: i32 decline
+    4: i32 flat
+    5: i32 limit_up
+    6: i32 limit_down
+    7: double amount // CNY
+}
+
+// MarketBreadth is the advance/decline picture of all A-share stocks
+struct MarketBreadth {
+    1: string date
+    2: i32 advance
+    3: i32 decline
+    4: i32 flat
+    5: i32 suspended
+    6: i32 limit_up
+    7: i32 limit_down
+    8: double amount // Total turnover (CNY)
+    9: list<BreadthBucket> buckets
+    10: list<BreadthBoard> boards
+}
+
+struct GetMarketBreadthRequest {
+    1: string date       // Optional, YYYY-MM-DD, default the latest session, including a running one
+    2: i32 history_days  // Stored sessions before date to return, default 0, at most 20
+}
+
+struct GetMarketBreadthResponse {
+    1: MarketBreadth breadth
+    2: double prev_amount            // Turnover of the previous stored session, 0 when unknown
+    3: double amount_change_percent  // Turnover versus prev_amount (%)
+    4: list<MarketBreadth> history   // Oldest first
+}
+
+struct GetSentimentSnapshotRequest {
+    1: string date // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetSentimentSnapshotResponse {
+    1: SentimentSnapshot snapshot
+}
+
+// SentimentDay is one stored trading day, collected after the close
+struct SentimentDay {
+    1: string date
+    2: i32 limit_up_count
+    3: i32 broken_count
+    4: i32 limit_down_count
+    5: double broken_rate
+    6: i32 highest_board
+    7: double sentiment_score // Market temperature, 0-100, see GetMarketTemperature
+}
+
+struct GetSentimentHistoryRequest {
+    1: string start_date // Optional, YYYY-MM-DD, default 60 days before end_date
+    2: string end_date   // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetSentimentHistoryResponse {
+    1: list<SentimentDay> days // Oldest first, days not collected are missing
+}
+
+struct TemperatureComponent {
+    1: string name    // "breadth", "limit_up", "seal", "height", "limit_down" or "turnover"
+    2: double value   // Sub-score, 0-100
+    3: double weight  // Share of the temperature (%), 0 when its data was missing that day
+}
+
+// TemperatureDay is the composite market temperature (情绪温度) of one stored day
+struct TemperatureDay {
+    1: string date
+    2: double score // 0-100
+    3: list<TemperatureComponent> components
+}
+
+struct GetMarketTemperatureRequest {
+    1: string start_date // Optional, YYYY-MM-DD, default 60 days before end_date
+    2: string end_date   // Optional, YYYY-MM-DD, default latest completed trading day
+}
+
+struct GetMarketTemperatureResponse {
+    1: list<TemperatureDay> days // Oldest first, days not collected are missing
 }
 
 service StockService {
     GetRealtimeResponse GetRealtime(1: GetRealtimeRequest req)
+    GetRealtimeBatchResponse GetRealtimeBatch(1: GetRealtimeBatchRequest req)
     GetFinancialReportResponse GetFinancialReport(1: GetFinancialReportRequest req)
+    GetFinancialStatementsResponse GetFinancialStatements(1: GetFinancialStatementsRequest req)
+    GetValuationResponse GetValuation(1: GetValuationRequest req)
+    GetEarningsCalendarResponse GetEarningsCalendar(1: GetEarningsCalendarRequest req)
+    GetShareUnlocksResponse GetShareUnlocks(1: GetShareUnlocksRequest req)
+    GetDividendsResponse GetDividends(1: GetDividendsRequest req)
+    GetCorporateActionCalendarResponse GetCorporateActionCalendar(1: GetCorporateActionCalendarRequest req)
+    GetKLineResponse GetKLine(1: GetKLineRequest req)
+    GetIntradayTrendResponse GetIntradayTrend(1: GetIntradayTrendRequest req)
+    GetStockFundFlowResponse GetStockFundFlow(1: GetStockFundFlowRequest req)
+    GetIndexOverviewResponse GetIndexOverview(1: GetIndexOverviewRequest req)
+    SearchSecuritiesResponse SearchSecurities(1: SearchSecuritiesRequest req)
     
     // New methods
     GetMarketSectorsResponse GetMarketSectors(1: GetMarketSectorsRequest req)
+    GetSectorTimelineResponse GetSectorTimeline(1: GetSectorTimelineRequest req)
+    GetSectorRotationResponse GetSectorRotation(1: GetSectorRotationRequest req)
+    GetSectorFundFlowResponse GetSectorFundFlow(1: GetSectorFundFlowRequest req)
     GetLimitUpPoolResponse GetLimitUpPool(1: GetLimitUpPoolRequest req)
+    GetLimitUpLadderResponse GetLimitUpLadder(1: GetLimitUpLadderRequest req)
+    GetBrokenLimitPoolResponse GetBrokenLimitPool(1: GetBrokenLimitPoolRequest req)
+    GetLimitDownPoolResponse GetLimitDownPool(1: GetLimitDownPoolRequest req)
+    GetSentimentSnapshotResponse GetSentimentSnapshot(1: GetSentimentSnapshotRequest req)
+    GetMarketBreadthResponse GetMarketBreadth(1: GetMarketBreadthRequest req)
+    GetSentimentHistoryResponse GetSentimentHistory(1: GetSentimentHistoryRequest req)
+    GetMarketTemperatureResponse GetMarketTemperature(1: GetMarketTemperatureRequest req)
+    GetNorthboundFlowResponse GetNorthboundFlow(1: GetNorthboundFlowRequest req)
+    GetNorthboundTopStocksResponse GetNorthboundTopStocks(1: GetNorthboundTopStocksRequest req)
+    GetNorthboundHoldingResponse GetNorthboundHolding(1: GetNorthboundHoldingRequest req)
+    GetMarginTradingResponse GetMarginTrading(1: GetMarginTradingRequest req)
+    GetMarketMarginSummaryResponse GetMarketMarginSummary(1: GetMarketMarginSummaryRequest req)
 
     // Phase 2: Sector Details & Dragon Tiger List
     GetSectorStocksResponse GetSectorStocks(1: GetSectorStocksRequest req)
@@ -1,6 +1,34 @@
 import client from './client';
 import {
   RealtimeResponse,
+  GetRealtimeBatchResponse,
+  GetKLineRequest,
+  GetKLineResponse,
+  GetIntradayTrendResponse,
+  GetStockFundFlowResponse,
+  NorthboundHoldingResponse,
+  MarginTradingResponse,
+  FinancialView,
+  FinancialStatementsResponse,
+  ValuationResponse,
+  EarningsCalendarResponse,
+  ShareUnlocksResponse,
+  DividendsResponse,
+  CorporateActionCalendarResponse,
+  GetIndexOverviewResponse,
+  SentimentSnapshot,
+  SentimentHistoryResponse,
+  MarketTemperatureResponse,
+  NorthboundFlowResponse,
+  NorthboundTopStocksResponse,
+  MarketMarginSummaryResponse,
+  SectorTimelineResponse,
+  SectorRotationResponse,
+  SectorFundFlowRequest,
+  SectorFundFlowResponse,
+  LimitUpLadderResponse,
+  MarketBreadthResponse,
+  SearchSecuritiesResponse,
   PredictionResponse,
   PredictionRequest,
   ImageRecognitionResponse,
@@ -17,6 +45,192 @@ export const getRealtime = async (code: string): Promise<RealtimeResponse> => {
   return response.data;
 };
 
+export const getRealtimeBatch = async (codes: string[]): Promise<GetRealtimeBatchResponse> => {
+  const response = await client.get<GetRealtimeBatchResponse>('/api/stocks/realtime', {
+    params: { codes: codes.join(',') },
+  });
+  return response.data;
+};
+
+export const getKLine = async (code: string, req: GetKLineRequest = {}): Promise<GetKLineResponse> => {
+  const response = await client.get<GetKLineResponse>(`/api/stocks/${code}/kline`, { params: req });
+  return response.data;
+};
+
+export const getIntradayTrend = async (code: string, date?: string): Promise<GetIntradayTrendResponse> => {
+  const response = await client.get<GetIntradayTrendResponse>(`/api/stocks/${code}/intraday`, {
+    params: date ? { date } : undefined,
+  });
+  return response.data;
+};
+
+export const getStockFundFlow = async (code: string, days?: number): Promise<GetStockFundFlowResponse> => {
+  const response = await client.get<GetStockFundFlowResponse>(`/api/stocks/${code}/fundflow`, {
+    params: days ? { days } : undefined,
+  });
+  return response.data;
+};
+
+export const getNorthboundHolding = async (code: string, days?: number): Promise<NorthboundHoldingResponse> => {
+  const response = await client.get<NorthboundHoldingResponse>(`/api/stocks/${code}/northbound`, {
+    params: days ? { days } : undefined,
+  });
+  return response.data;
+};
+
+export const getMarginTrading = async (code: string, days?: number): Promise<MarginTradingResponse> => {
+  const response = await client.get<MarginTradingResponse>(`/api/stocks/${code}/margin`, {
+    params: days ? { days } : undefined,
+  });
+  return response.data;
+};
+
+export const getFinancialStatements = async (
+  code: string,
+  view?: FinancialView,
+  periods?: number,
+): Promise<FinancialStatementsResponse> => {
+  const response = await client.get<FinancialStatementsResponse>(`/api/stocks/${code}/financial/statements`, {
+    params: { view, periods },
+  });
+  return response.data;
+};
+
+export const getValuation = async (code: string, years?: number): Promise<ValuationResponse> => {
+  const response = await client.get<ValuationResponse>(`/api/stocks/${code}/valuation`, {
+    params: years ? { years } : undefined,
+  });
+  return response.data;
+};
+
+export const getStockEarnings = async (code: string, start?: string, end?: string): Promise<EarningsCalendarResponse> => {
+  const response = await client.get<EarningsCalendarResponse>(`/api/stocks/${code}/earnings`, {
+    params: { start, end },
+  });
+  return response.data;
+};
+
+export const getShareUnlocks = async (code: string, start?: string, end?: string): Promise<ShareUnlocksResponse> => {
+  const response = await client.get<ShareUnlocksResponse>(`/api/stocks/${code}/unlocks`, {
+    params: { start, end },
+  });
+  return response.data;
+};
+
+export const getDividends = async (code: string, start?: string, end?: string): Promise<DividendsResponse> => {
+  const response = await client.get<DividendsResponse>(`/api/stocks/${code}/dividends`, {
+    params: { start, end },
+  });
+  return response.data;
+};
+
+export const getIndexOverview = async (codes?: string[]): Promise<GetIndexOverviewResponse> => {
+  const response = await client.get<GetIndexOverviewResponse>('/api/market/indices', {
+    params: codes && codes.length > 0 ? { codes: codes.join(',') } : undefined,
+  });
+  return response.data;
+};
+
+export const getSentimentSnapshot = async (date?: string): Promise<SentimentSnapshot> => {
+  const response = await client.get<SentimentSnapshot>('/api/market/sentiment', {
+    params: date ? { date } : undefined,
+  });
+  return response.data;
+};
+
+export const getSentimentHistory = async (startDate?: string, endDate?: string): Promise<SentimentHistoryResponse> => {
+  const response = await client.get<SentimentHistoryResponse>('/api/market/sentiment/history', {
+    params: { start_date: startDate, end_date: endDate },
+  });
+  return response.data;
+};
+
+export const getMarketTemperature = async (startDate?: string, endDate?: string): Promise<MarketTemperatureResponse> => {
+  const response = await client.get<MarketTemperatureResponse>('/api/market/temperature', {
+    params: { start_date: startDate, end_date: endDate },
+  });
+  return response.data;
+};
+
+export const getNorthboundFlow = async (days?: number): Promise<NorthboundFlowResponse> => {
+  const response = await client.get<NorthboundFlowResponse>('/api/market/northbound', {
+    params: days ? { days } : undefined,
+  });
+  return response.data;
+};
+
+export const getNorthboundTopStocks = async (date?: string): Promise<NorthboundTopStocksResponse> => {
+  const response = await client.get<NorthboundTopStocksResponse>('/api/market/northbound/top', {
+    params: date ? { date } : undefined,
+  });
+  return response.data;
+};
+
+export const getMarketMarginSummary = async (days?: number): Promise<MarketMarginSummaryResponse> => {
+  const response = await client.get<MarketMarginSummaryResponse>('/api/market/margin', {
+    params: days ? { days } : undefined,
+  });
+  return response.data;
+};
+
+export const getEarningsCalendar = async (start?: string, end?: string): Promise<EarningsCalendarResponse> => {
+  const response = await client.get<EarningsCalendarResponse>('/api/market/earnings', {
+    params: { start, end },
+  });
+  return response.data;
+};
+
+export const getCorporateActionCalendar = async (start?: string, end?: string): Promise<CorporateActionCalendarResponse> => {
+  const response = await client.get<CorporateActionCalendarResponse>('/api/market/corporate_actions', {
+    params: { start, end },
+  });
+  return response.data;
+};
+
+export const getSectorTimeline = async (sectorCode: string, date?: string): Promise<SectorTimelineResponse> => {
+  const response = await client.get<SectorTimelineResponse>('/api/market/sector/timeline', {
+    params: { sector_code: sectorCode, date },
+  });
+  return response.data;
+};
+
+export const getSectorRotation = async (
+  days = 5,
+  type: 'concept' | 'industry' = 'concept',
+  limit = 20,
+): Promise<SectorRotationResponse> => {
+  const response = await client.get<SectorRotationResponse>('/api/market/sector/rotation', {
+    params: { days, type, limit },
+  });
+  return response.data;
+};
+
+export const getSectorFundFlow = async (req: SectorFundFlowRequest = {}): Promise<SectorFundFlowResponse> => {
+  const response = await client.get<SectorFundFlowResponse>('/api/market/sector/fundflow', { params: req });
+  return response.data;
+};
+
+export const getLimitUpLadder = async (date?: string): Promise<LimitUpLadderResponse> => {
+  const response = await client.get<LimitUpLadderResponse>('/api/market/limit_up/ladder', {
+    params: date ? { date } : undefined,
+  });
+  return response.data;
+};
+
+export const getMarketBreadth = async (date?: string, historyDays = 0): Promise<MarketBreadthResponse> => {
+  const response = await client.get<MarketBreadthResponse>('/api/market/breadth', {
+    params: { date, history_days: historyDays },
+  });
+  return response.data;
+};
+
+export const searchSecurities = async (query: string, limit = 10): Promise<SearchSecuritiesResponse> => {
+  const response = await client.get<SearchSecuritiesResponse>('/api/stocks/search', {
+    params: { q: query, limit },
+  });
+  return response.data;
+};
+
 export const recognizeStockImage = async (imageUri: string, imageType: string, imageName: string): Promise<ImageRecognitionResponse> => {
   const formData = new FormData();
   formData.append('image', {
@@ -1,14 +1,58 @@
 import React, { useEffect, useState, useCallback, useRef } from 'react';
 import { View, StyleSheet, ScrollView, Alert, SafeAreaView, Platform, StatusBar, TouchableOpacity } from 'react-native';
 import { Card, Text, FAB, Dialog, Portal, TextInput, Button, ActivityIndicator, Divider } from 'react-native-paper';
-import { getRealtime, recognizeStockImage, getPrediction } from '../api/stock';
-import { RealtimeResponse, PredictionResponse } from '../types';
+import { getRealtimeBatch, recognizeStockImage, getPrediction, getEarningsCalendar } from '../api/stock';
+import { RealtimeResponse, PredictionResponse, EarningsCalendarResponse } from '../types';
 import { useNavigation, useFocusEffect } from '@react-navigation/native';
 import AsyncStorage from '@react-native-async-storage/async-storage';
 import { launchImageLibrary } from 'react-native-image-picker';
 
 const WATCHLIST_KEY = 'stock_watchlist';
 
+// Earnings events within this many days of today are flagged on the watchlist
+const EARNINGS_FLAG_DAYS = 7;
+
+const REPORT_NAMES: { [key: string]: string } = { Q1: '一季报', H1: '中报', Q3: '三季报', FY: '年报' };
+
+const formatDate = (d: Date) => {
+  const pad = (n: number) => (n < 10 ? `0${n}` : `${n}`);
+  return `${d.getFullYear()}-${pad(d.getMonth() + 1)}-${pad(d.getDate())}`;
+};
+
+// earningsFlags builds one line per 6-digit code: the report due soon and the latest net profit preview
+const earningsFlags = (calendar: EarningsCalendarResponse, today: Date): { [code: string]: string } => {
+  const flags: { [code: string]: string[] } = {};
+  const add = (code: string, flag: string) => {
+    flags[code] = [...(flags[code] || []), flag];
+  };
+  const todayStr = formatDate(today);
+  const todayStart = new Date(today.getFullYear(), today.getMonth(), today.getDate()).getTime();
+
+  (calendar.disclosures || []).forEach(d => {
+    if (d.actual_date || d.scheduled_date < todayStr) {
+      return;
+    }
+    const [y, m, day] = d.scheduled_date.split('-').map(Number);
+    const days = Math.round((new Date(y, m - 1, day).getTime() - todayStart) / 86400000);
+    const when = days === 0 ? '今日' : `${days}天后`;
+    add(d.code, `${when}披露${REPORT_NAMES[d.report_type] || '财报'}`);
+  });
+  // Previews come oldest first, the latest one of a stock wins
+  const previews: { [code: string]: string } = {};
+  (calendar.previews || []).forEach(p => {
+    if (p.indicator.includes('净利润') && !p.indicator.includes('扣除')) {
+      previews[p.code] = `预告${p.type} 净利${p.change_lower > 0 ? '+' : ''}${p.change_lower.toFixed(0)}%`;
+    }
+  });
+  Object.entries(previews).forEach(([code, flag]) => add(code, flag));
+
+  const result: { [code: string]: string } = {};
+  Object.entries(flags).forEach(([code, list]) => {
+    result[code] = list.join(' · ');
+  });
+  return result;
+};
+
 const HomeScreen = () => {
   const [stocks, setStocks] = useState<RealtimeResponse[]>([]);
   const [loading, setLoading] = useState(false);
@@ -20,6 +64,7 @@ const HomeScreen = () => {
   const [predictions, setPredictions] = useState<{[key: string]: PredictionResponse}>({});
   const [predicting, setPredicting] = useState<{[key: string]: boolean}>({});
   const [expandedStock, setExpandedStock] = useState<string | null>(null);
+  const [earnings, setEarnings] = useState<{ [code: string]: string }>({});
 
   const navigation = useNavigation();
 
@@ -31,6 +76,7 @@ const HomeScreen = () => {
   // Load watchlist on mount
   useEffect(() => {
     loadWatchlist();
+    loadEarnings();
   }, []);
 
   const loadWatchlist = async () => {
@@ -71,6 +117,19 @@ const HomeScreen = () => {
     }
   };
 
+  // The market-wide calendar around today, a failure only hides the flags
+  const loadEarnings = async () => {
+    const today = new Date();
+    const start = new Date(today.getTime() - EARNINGS_FLAG_DAYS * 86400000);
+    const end = new Date(today.getTime() + EARNINGS_FLAG_DAYS * 86400000);
+    try {
+      const calendar = await getEarningsCalendar(formatDate(start), formatDate(end));
+      setEarnings(earningsFlags(calendar, today));
+    } catch (error: any) {
+      console.error('loadEarnings failed', error);
+    }
+  };
+
   const fetchStocks = async () => {
     if (watchlist.length === 0) {
       setStocks([]);
@@ -78,9 +137,8 @@ const HomeScreen = () => {
     }
     setLoading(true);
     try {
-      const promises = watchlist.map(code => getRealtime(code));
-      // Use Promise.allSettled to avoid entire failure if one stock fails
-      const results = await Promise.allSettled(promises);
+      // One request for the whole watchlist, failed codes are reported per code
+      const result = await getRealtimeBatch(watchlist);
 
       const validStocks: RealtimeResponse[] = [];
       const seenCodes = new Set<string>();
@@ -88,23 +146,21 @@ const HomeScreen = () => {
       // Get the latest watchlist from ref to avoid race conditions with delete operations
       const currentWatchlistSet = new Set(watchlistRef.current.map(c => c.trim().toLowerCase()));
 
-      results.forEach((result, index) => {
-        if (result.status === 'fulfilled') {
-          const stock = result.value;
-          const stockCode = stock.code.trim().toLowerCase();
-
-          // Only add if it's still in the watchlist
-          if (currentWatchlistSet.has(stockCode)) {
-            // De-duplicate stocks based on code
-            if (!seenCodes.has(stockCode)) {
-              seenCodes.add(stockCode);
-              validStocks.push(stock);
-            }
+      (result.stocks || []).forEach(stock => {
+        const stockCode = stock.code.trim().toLowerCase();
+
+        // Only add if it's still in the watchlist
+        if (currentWatchlistSet.has(stockCode)) {
+          // De-duplicate stocks based on code
+          if (!seenCodes.has(stockCode)) {
+            seenCodes.add(stockCode);
+            validStocks.push(stock);
           }
-        } else {
-          console.error(`Failed to fetch stock ${watchlist[index]}:`, result.reason);
         }
       });
+      Object.entries(result.errors || {}).forEach(([code, reason]) => {
+        console.error(`Failed to fetch stock ${code}:`, reason);
+      });
 
       setStocks(validStocks);
     } catch (error: any) {
@@ -268,6 +324,9 @@ const HomeScreen = () => {
                 <View>
                   <Text variant="titleMedium" style={styles.stockName}>{stock.name}</Text>
                   <Text variant="bodySmall" style={styles.stockCode}>{stock.code}</Text>
+                  {earnings[stock.code.slice(-6)] && (
+                    <Text variant="bodySmall" style={styles.earningsFlag}>{earnings[stock.code.slice(-6)]}</Text>
+                  )}
                 </View>
                 <View style={styles.rightGroup}>
                   <View style={styles.priceContainer}>
@@ -415,6 +474,9 @@ const styles = StyleSheet.create({
   stockCode: {
     color: '#757575',
   },
+  earningsFlag: {
+    color: '#E65100',
+  },
   priceContainer: {
     flexDirection: 'row',
     alignItems: 'center',
@@ -2,13 +2,13 @@ import React, { useEffect, useState } from 'react';
 import { View, StyleSheet, ScrollView, Dimensions, TouchableOpacity } from 'react-native';
 import { Appbar, Card, Text, Divider, Chip, Button, ActivityIndicator } from 'react-native-paper';
 import { useNavigation } from '@react-navigation/native';
-import { getRealtime, marketReview } from '../api/stock';
-import { RealtimeResponse, MarketReviewResponse } from '../types';
+import { getIndexOverview, marketReview } from '../api/stock';
+import { IndexQuote, MarketReviewResponse } from '../types';
 import { PieChart } from 'react-native-chart-kit';
 
 const SummaryScreen = () => {
   const navigation = useNavigation();
-  const [indices, setIndices] = useState<RealtimeResponse[]>([]);
+  const [indices, setIndices] = useState<IndexQuote[]>([]);
   const [loading, setLoading] = useState(false);
   const [review, setReview] = useState<MarketReviewResponse | null>(null);
   const [reviewLoading, setReviewLoading] = useState(false);
@@ -25,11 +25,9 @@ const SummaryScreen = () => {
   const fetchIndices = async () => {
     setLoading(true);
     try {
-      // Fetch major indices
-      const codes = ['sh000001', 'sz399001', 'sz399006']; // ShangZheng, ShenZheng, ChuangYe
-      const promises = codes.map(c => getRealtime(c));
-      const results = await Promise.all(promises);
-      setIndices(results);
+      // Fetch major indices (server default set: 上证, 深成, 创业板, 科创50, 北证50)
+      const res = await getIndexOverview();
+      setIndices(res.indices || []);
     } catch (error) {
       console.error(error);
     } finally {
@@ -100,6 +98,9 @@ const SummaryScreen = () => {
                 <Text variant="bodySmall" style={{ color: getColor(index.change_percent) }}>
                   {index.change_percent > 0 ? '+' : ''}{index.change_percent.toFixed(2)}%
                 </Text>
+                <Text variant="bodySmall" style={{ color: '#757575' }}>
+                  涨{index.up_count} 跌{index.down_count} 平{index.flat_count}
+                </Text>
               </Card.Content>
             </Card>
           ))}
@@ -5,6 +5,564 @@ export interface RealtimeResponse {
   change_percent: number;
   volume: number;
   timestamp: string;
+  source?: string;
+  open?: number;
+  high?: number;
+  low?: number;
+  prev_close?: number;
+  amount?: number;
+  order_book?: OrderBook;
+  wei_bi?: number;
+  wei_cha?: number;
+}
+
+export interface OrderBookLevel {
+  price: number;
+  volume: number;
+}
+
+export interface OrderBook {
+  bids: OrderBookLevel[];
+  asks: OrderBookLevel[];
+}
+
+export interface GetRealtimeBatchResponse {
+  stocks: RealtimeResponse[];
+  errors: { [code: string]: string };
+}
+
+export interface KLineBar {
+  date: string;
+  open: number;
+  close: number;
+  high: number;
+  low: number;
+  volume: number;
+  amount: number;
+  change_percent: number;
+  turnover_rate: number;
+}
+
+export interface GetKLineRequest {
+  period?: '1m' | '5m' | '15m' | '30m' | '60m' | 'day' | 'week' | 'month';
+  adjust?: 'none' | 'forward' | 'backward';
+  start_date?: string;
+  end_date?: string;
+  limit?: number;
+}
+
+export interface GetKLineResponse {
+  code: string;
+  name: string;
+  bars: KLineBar[];
+}
+
+export interface IntradayPoint {
+  time: string;
+  price: number;
+  avg_price: number;
+  volume: number;
+  amount: number;
+}
+
+export interface GetIntradayTrendResponse {
+  code: string;
+  name: string;
+  date: string;
+  prev_close: number;
+  points: IntradayPoint[];
+}
+
+export interface FundFlowDay {
+  date: string;
+  main_net: number;
+  super_large_net: number;
+  large_net: number;
+  medium_net: number;
+  small_net: number;
+  main_net_ratio: number;
+  close: number;
+  change_percent: number;
+}
+
+export interface FundFlowMinute {
+  time: string;
+  main_net: number;
+  super_large_net: number;
+  large_net: number;
+  medium_net: number;
+  small_net: number;
+}
+
+export interface GetStockFundFlowResponse {
+  code: string;
+  name: string;
+  days: FundFlowDay[];
+  intraday_date: string;
+  intraday: FundFlowMinute[];
+}
+
+export interface NorthboundDay {
+  date: string;
+  net_buy: number;
+  sh_net_buy: number;
+  sz_net_buy: number;
+  deal_amount: number;
+  sh_deal_amount: number;
+  sz_deal_amount: number;
+}
+
+export interface NorthboundMinute {
+  time: string;
+  net_buy: number;
+  sh_net_buy: number;
+  sz_net_buy: number;
+}
+
+export interface NorthboundFlowResponse {
+  days: NorthboundDay[];
+  intraday_date: string;
+  intraday: NorthboundMinute[];
+}
+
+export interface NorthboundTopStock {
+  connect: 'sh' | 'sz';
+  rank: number;
+  code: string;
+  name: string;
+  close: number;
+  change_percent: number;
+  net_buy: number;
+  buy_amount: number;
+  sell_amount: number;
+  deal_amount: number;
+}
+
+export interface NorthboundTopStocksResponse {
+  date: string;
+  stocks: NorthboundTopStock[];
+}
+
+export interface NorthboundHolding {
+  date: string;
+  shares: number;
+  market_cap: number;
+  float_ratio: number;
+  shares_change: number;
+  market_cap_change: number;
+  close: number;
+  change_percent: number;
+}
+
+export interface NorthboundHoldingResponse {
+  code: string;
+  name: string;
+  holdings: NorthboundHolding[];
+}
+
+export interface MarginDay {
+  date: string;
+  financing_balance: number;
+  financing_buy: number;
+  financing_repay: number;
+  financing_net_buy: number;
+  lending_balance: number;
+  lending_volume: number;
+  total_balance: number;
+  financing_balance_change: number;
+  lending_balance_change: number;
+  total_balance_change: number;
+  close: number;
+  change_percent: number;
+}
+
+export interface MarginTradingResponse {
+  code: string;
+  name: string;
+  days: MarginDay[];
+}
+
+export interface MarketMarginSummaryResponse {
+  days: MarginDay[];
+}
+
+export interface IncomeStatement {
+  total_revenue: number;
+  revenue: number;
+  operating_cost: number;
+  selling_expense: number;
+  admin_expense: number;
+  rd_expense: number;
+  finance_expense: number;
+  operating_profit: number;
+  total_profit: number;
+  income_tax: number;
+  net_profit: number;
+  parent_net_profit: number;
+  deducted_net_profit: number;
+  eps: number;
+}
+
+export interface BalanceSheet {
+  total_assets: number;
+  current_assets: number;
+  cash: number;
+  receivables: number;
+  inventory: number;
+  fixed_assets: number;
+  goodwill: number;
+  total_liabilities: number;
+  current_liabilities: number;
+  short_term_loans: number;
+  long_term_loans: number;
+  total_equity: number;
+  parent_equity: number;
+}
+
+export interface CashFlowStatement {
+  operating_cash_flow: number;
+  investing_cash_flow: number;
+  financing_cash_flow: number;
+  capex: number;
+  net_cash_change: number;
+  ending_cash: number;
+}
+
+export interface FinancialRatios {
+  roe: number;
+  gross_margin: number;
+  net_margin: number;
+  debt_ratio: number;
+  ocf_to_net_profit: number;
+}
+
+export type FinancialView = 'report' | 'quarter' | 'annual' | 'ttm';
+
+export interface FinancialPeriod {
+  report_date: string;
+  report_type: 'Q1' | 'H1' | 'Q3' | 'FY';
+  income: IncomeStatement;
+  balance: BalanceSheet;
+  cash_flow: CashFlowStatement;
+  ratios: FinancialRatios;
+}
+
+export interface FinancialStatementsResponse {
+  code: string;
+  name: string;
+  view: FinancialView;
+  periods: FinancialPeriod[];
+}
+
+export interface ValuationDay {
+  date: string;
+  close: number;
+  pe_ttm: number;
+  pb: number;
+  ps_ttm: number;
+  market_cap: number;
+}
+
+export interface ValuationBand {
+  current: number;
+  percentile: number;
+  min: number;
+  median: number;
+  max: number;
+  industry_median: number;
+}
+
+export interface ValuationResponse {
+  code: string;
+  name: string;
+  industry: string;
+  years: number;
+  days: ValuationDay[];
+  pe_ttm: ValuationBand;
+  pb: ValuationBand;
+  ps_ttm: ValuationBand;
+}
+
+export interface EarningsDisclosure {
+  code: string;
+  name: string;
+  report_date: string;
+  report_type: 'Q1' | 'H1' | 'Q3' | 'FY';
+  scheduled_date: string;
+  first_scheduled_date: string;
+  reschedules: number;
+  actual_date: string;
+}
+
+export interface EarningsPreview {
+  code: string;
+  name: string;
+  report_date: string;
+  notice_date: string;
+  indicator: string;
+  type: string;
+  lower: number;
+  upper: number;
+  change_lower: number;
+  change_upper: number;
+  last_year: number;
+  reason: string;
+}
+
+export interface EarningsExpress {
+  code: string;
+  name: string;
+  report_date: string;
+  notice_date: string;
+  revenue: number;
+  revenue_yoy: number;
+  net_profit: number;
+  net_profit_yoy: number;
+  eps: number;
+  roe: number;
+}
+
+export interface EarningsCalendarResponse {
+  start: string;
+  end: string;
+  disclosures: EarningsDisclosure[];
+  previews: EarningsPreview[];
+  expresses: EarningsExpress[];
+}
+
+export interface ShareUnlock {
+  code: string;
+  name: string;
+  date: string;
+  shares: number;
+  market_cap: number;
+  float_ratio: number;
+  holder_type: string;
+}
+
+export interface DividendPlan {
+  code: string;
+  name: string;
+  report_date: string;
+  notice_date: string;
+  record_date: string;
+  ex_date: string;
+  cash_per_ten: number;
+  bonus_per_ten: number;
+  transfer_per_ten: number;
+  progress: string;
+  plan: string;
+}
+
+export interface ShareUnlocksResponse {
+  code: string;
+  start: string;
+  end: string;
+  unlocks: ShareUnlock[];
+}
+
+export interface DividendsResponse {
+  code: string;
+  start: string;
+  end: string;
+  dividends: DividendPlan[];
+}
+
+export interface CorporateActionCalendarResponse {
+  start: string;
+  end: string;
+  unlocks: ShareUnlock[];
+  dividends: DividendPlan[];
+}
+
+export interface IndexQuote {
+  code: string;
+  name: string;
+  current_price: number;
+  change: number;
+  change_percent: number;
+  amount: number;
+  up_count: number;
+  down_count: number;
+  flat_count: number;
+}
+
+export interface GetIndexOverviewResponse {
+  indices: IndexQuote[];
+}
+
+export interface SentimentSnapshot {
+  date: string;
+  limit_up_count: number;
+  broken_count: number;
+  limit_down_count: number;
+  broken_rate: number; // 炸板率 (%)
+  highest_board: number;
+}
+
+export interface SentimentDay extends SentimentSnapshot {
+  sentiment_score: number; // Market temperature, 0-100
+}
+
+export interface TemperatureComponent {
+  name: 'breadth' | 'limit_up' | 'seal' | 'height' | 'limit_down' | 'turnover';
+  value: number; // Sub-score, 0-100
+  weight: number; // Share of the score (%), 0 when the data was missing
+}
+
+export interface TemperatureDay {
+  date: string;
+  score: number; // 情绪温度, 0-100
+  components: TemperatureComponent[];
+}
+
+export interface MarketTemperatureResponse {
+  days: TemperatureDay[]; // Oldest first
+}
+
+export interface SentimentHistoryResponse {
+  days: SentimentDay[];
+}
+
+export interface SectorTimelinePoint {
+  time: string; // HH:MM:SS
+  rank: number; // 1-based, by change percent
+  total: number;
+  change_percent: number;
+  net_inflow: number;
+  top_stock_name: string;
+}
+
+export interface SectorTimelineResponse {
+  sector_code: string;
+  name: string;
+  type: 'concept' | 'industry';
+  date: string;
+  points: SectorTimelinePoint[];
+}
+
+export interface SectorRotationItem {
+  code: string;
+  name: string;
+  type: 'concept' | 'industry';
+  ranks: number[]; // One per date, oldest first, 0 when not ranked
+  latest_rank: number;
+  total: number;
+  cumulative_change: number; // %
+  cumulative_net_inflow: number;
+  top10_streak: number;
+  momentum_score: number; // 0-100
+  trend: 'rising' | 'fading' | 'steady';
+}
+
+export interface SectorRotationResponse {
+  dates: string[];
+  sectors: SectorRotationItem[];
+}
+
+export interface SectorFundFlowItem {
+  code: string;
+  name: string;
+  type: string;
+  change_percent: number;
+  main_net_inflow: number;
+  main_net_ratio: number;
+  top_stock_name: string;
+  daily_net_inflow: number[];
+  inflow_streak: number;
+}
+
+export interface SectorFundFlowRequest {
+  type?: 'concept' | 'industry';
+  period?: 'today' | '5d' | '10d';
+  limit?: number;
+  history_days?: number;
+  min_streak?: number;
+}
+
+export interface SectorFundFlowResponse {
+  type: string;
+  period: string;
+  dates: string[];
+  sectors: SectorFundFlowItem[];
+}
+
+export interface LadderStock {
+  code: string;
+  name: string;
+  price: number;
+  change_percent: number;
+  reason: string; // Industry
+  first_seal_time: string;
+  last_seal_time: string;
+  seal_amount: number;
+  open_count: number;
+  turnover_rate: number;
+  concept: string; // Top concept board, may be empty
+}
+
+export interface LimitUpLadderLevel {
+  height: number; // 1 for 首板
+  stocks: LadderStock[];
+  prev_count: number;
+  promoted_count: number;
+  promotion_rate: number; // 晋级率 (%)
+}
+
+export interface LimitUpLadderResponse {
+  date: string;
+  prev_date: string;
+  levels: LimitUpLadderLevel[]; // Highest first
+}
+
+export interface BreadthBucket {
+  label: string; // "limit_up", ">=7", "5~7", ... "limit_down"
+  count: number;
+}
+
+export interface BreadthBoard {
+  board: 'main' | 'chinext' | 'star' | 'bse';
+  advance: number;
+  decline: number;
+  flat: number;
+  limit_up: number;
+  limit_down: number;
+  amount: number;
+}
+
+export interface MarketBreadth {
+  date: string;
+  advance: number;
+  decline: number;
+  flat: number;
+  suspended: number;
+  limit_up: number;
+  limit_down: number;
+  amount: number; // Total turnover (CNY)
+  buckets: BreadthBucket[];
+  boards: BreadthBoard[];
+}
+
+export interface MarketBreadthResponse {
+  breadth: MarketBreadth;
+  prev_amount: number;
+  amount_change_percent: number;
+  history: MarketBreadth[]; // Oldest first
+}
+
+export interface SecurityInfo {
+  code: string;
+  symbol: string;
+  exchange: 'SH' | 'SZ' | 'BJ';
+  board: string;
+  type: 'stock' | 'etf' | 'index';
+  name: string;
+  pinyin: string;
+  listing_date: string;
+}
+
+export interface SearchSecuritiesResponse {
+  securities: SecurityInfo[];
 }
 
 export interface PredictionResponse {